@@ -0,0 +1,227 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package ai
+
+import (
+	"FoodStats/internal/config"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+var errNoJSONArray = errors.New("model reply contains no JSON array")
+
+const systemPrompt = "You are a nutrition assistant inside the FoodStats app. Be concise and factual."
+
+// OpenAIProvider talks to any server implementing the OpenAI chat
+// completions API, such as a locally hosted model. Candidate recipes and
+// nutrition numbers come from the fallback provider; the model reranks
+// them and writes the advice. When the server fails, the fallback's
+// answer is returned unchanged.
+type OpenAIProvider struct {
+	baseURL  string
+	model    string
+	apiKey   string
+	client   *http.Client
+	fallback Provider
+}
+
+func NewOpenAIProvider(baseURL, model, apiKey string, fallback Provider) *OpenAIProvider {
+	return &OpenAIProvider{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		model:    model,
+		apiKey:   apiKey,
+		client:   &http.Client{Timeout: config.GetAITimeout()},
+		fallback: fallback,
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (p *OpenAIProvider) complete(ctx context.Context, prompt string) (string, error) {
+	body, err := json.Marshal(chatRequest{
+		Model: p.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: prompt},
+		},
+		Temperature: 0.2,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("model server request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("model server returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var chat chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&chat); err != nil {
+		return "", fmt.Errorf("failed to parse model server response: %v", err)
+	}
+	if len(chat.Choices) == 0 {
+		return "", errors.New("model server returned no choices")
+	}
+	return strings.TrimSpace(chat.Choices[0].Message.Content), nil
+}
+
+// completeList asks for a JSON array of strings and tolerates prose or code
+// fences around it.
+func (p *OpenAIProvider) completeList(ctx context.Context, prompt string) ([]string, error) {
+	reply, err := p.complete(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := strings.Index(reply, "["), strings.LastIndex(reply, "]")
+	if start < 0 || end < start {
+		return nil, errNoJSONArray
+	}
+	var list []string
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &list); err != nil {
+		return nil, fmt.Errorf("%w: %v", errNoJSONArray, err)
+	}
+	return list, nil
+}
+
+func (p *OpenAIProvider) RecommendRecipes(ctx context.Context, ingredients []string, limit int) ([]config.Recipe, error) {
+	candidates, err := p.fallback.RecommendRecipes(ctx, ingredients, limit)
+	if err != nil || len(candidates) < 2 {
+		return candidates, err
+	}
+
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Available ingredients: %s.\nCandidate recipes:\n", strings.Join(ingredients, ", "))
+	for _, recipe := range candidates {
+		names := make([]string, 0, len(recipe.Ingredients))
+		for _, ing := range recipe.Ingredients {
+			names = append(names, ing.Name)
+		}
+		fmt.Fprintf(&prompt, "- %s: %s\n", recipe.Name, strings.Join(names, ", "))
+	}
+	prompt.WriteString("Order the candidate recipes from best to worst fit for the available ingredients. " +
+		"Reply with only a JSON array of recipe names.")
+
+	order, err := p.completeList(ctx, prompt.String())
+	if err != nil {
+		log.Printf("Model reranking failed, keeping rule-based order: %v", err)
+		return candidates, nil
+	}
+
+	byName := make(map[string]config.Recipe, len(candidates))
+	for _, recipe := range candidates {
+		byName[strings.ToLower(recipe.Name)] = recipe
+	}
+	ranked := make([]config.Recipe, 0, len(candidates))
+	for _, name := range order {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if recipe, ok := byName[key]; ok {
+			ranked = append(ranked, recipe)
+			delete(byName, key)
+		}
+	}
+	// Candidates the model left out follow in their original order.
+	for _, recipe := range candidates {
+		if _, ok := byName[strings.ToLower(recipe.Name)]; ok {
+			ranked = append(ranked, recipe)
+		}
+	}
+	return ranked, nil
+}
+
+func (p *OpenAIProvider) AnalyzeNutrition(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (*config.NutritionAnalysis, error) {
+	analysis, err := p.fallback.AnalyzeNutrition(ctx, ingredients, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := mealPrompt(ingredients, profile, analysis) +
+		"Give up to five short, practical suggestions to improve this meal. " +
+		"Reply with only a JSON array of strings."
+	recs, err := p.completeList(ctx, prompt)
+	if err != nil {
+		log.Printf("Model suggestions failed, keeping rule-based recommendations: %v", err)
+		return analysis, nil
+	}
+	if len(recs) > 0 {
+		analysis.Recommendations = recs
+	}
+	return analysis, nil
+}
+
+func (p *OpenAIProvider) Explain(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (string, error) {
+	analysis, err := p.fallback.AnalyzeNutrition(ctx, ingredients, profile)
+	if err != nil {
+		return "", err
+	}
+
+	prompt := mealPrompt(ingredients, profile, analysis) +
+		"Explain in a short paragraph what this analysis means for the user and why."
+	text, err := p.complete(ctx, prompt)
+	if err != nil || text == "" {
+		log.Printf("Model explanation failed, using rule-based explanation: %v", err)
+		return describeAnalysis(analysis), nil
+	}
+	return text, nil
+}
+
+// mealPrompt describes the meal, the profile and the rule-based analysis so
+// the model works from the same numbers the app shows.
+func mealPrompt(ingredients []config.Ingredient, profile *config.UserProfile, analysis *config.NutritionAnalysis) string {
+	var b strings.Builder
+	b.WriteString("Meal:\n")
+	for _, ing := range ingredients {
+		fmt.Fprintf(&b, "- %s, %.0f g: %.0f kcal, %.1f g protein, %.1f g carbs, %.1f g fat, %.1f g fiber\n",
+			ing.Name, ing.Grams, ing.Calories, ing.Proteins, ing.Carbs, ing.Fats, ing.Fiber)
+	}
+	if profile != nil {
+		fmt.Fprintf(&b, "User: %d years, %s, %.0f kg, %.0f cm, activity %s, goal %s",
+			profile.Age, profile.Gender, profile.Weight, profile.Height, profile.ActivityLevel, profile.Goal)
+		if len(profile.DietaryRestrictions) > 0 {
+			fmt.Fprintf(&b, ", restrictions: %s", strings.Join(profile.DietaryRestrictions, ", "))
+		}
+		b.WriteString(".\n")
+	}
+	fmt.Fprintf(&b, "Analysis: %s\n", describeAnalysis(analysis))
+	return b.String()
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package ai
+
+import (
+	"FoodStats/internal/config"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fixedRecommender returns the same recipes for every request.
+type fixedRecommender []config.Recipe
+
+func (r fixedRecommender) Recommend(available []string, k int) []config.Recipe {
+	return r
+}
+
+var testCandidates = fixedRecommender{
+	{Name: "Omelette", Ingredients: []config.Ingredient{{TemplateIngredient: config.TemplateIngredient{Name: "egg"}}}},
+	{Name: "Tomato Salad", Ingredients: []config.Ingredient{{TemplateIngredient: config.TemplateIngredient{Name: "tomato"}}}},
+	{Name: "Shakshuka", Ingredients: []config.Ingredient{{TemplateIngredient: config.TemplateIngredient{Name: "egg"}}}},
+	{Name: "Egg Fried Rice", Ingredients: []config.Ingredient{{TemplateIngredient: config.TemplateIngredient{Name: "rice"}}}},
+}
+
+var testMeal = []config.Ingredient{{
+	TemplateIngredient: config.TemplateIngredient{Name: "egg", Grams: 100},
+	NutritionalInfo:    config.NutritionalInfo{Calories: 155, Proteins: 13, Carbs: 1.1, Fats: 11},
+}}
+
+// modelServer answers chat completion requests with reply and records the
+// last request it got.
+func modelServer(t *testing.T, status int, reply string) (*httptest.Server, *chatRequest, *http.Header) {
+	t.Helper()
+	var got chatRequest
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" || r.Method != http.MethodPost {
+			http.NotFound(w, r)
+			return
+		}
+		header = r.Header.Clone()
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		if status != http.StatusOK {
+			http.Error(w, reply, status)
+			return
+		}
+		var resp chatResponse
+		resp.Choices = append(resp.Choices, struct {
+			Message chatMessage `json:"message"`
+		}{chatMessage{Role: "assistant", Content: reply}})
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &got, &header
+}
+
+func newTestProvider(url string) *OpenAIProvider {
+	return NewOpenAIProvider(url+"/v1", "test-model", "secret", NewRulesProvider(testCandidates))
+}
+
+func recipeNames(recipes []config.Recipe) []string {
+	names := make([]string, len(recipes))
+	for i, r := range recipes {
+		names[i] = r.Name
+	}
+	return names
+}
+
+func TestOpenAIExplainUsesModelReply(t *testing.T) {
+	srv, req, header := modelServer(t, http.StatusOK, "  A protein-rich breakfast.  ")
+	p := newTestProvider(srv.URL)
+
+	text, err := p.Explain(context.Background(), testMeal, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "A protein-rich breakfast." {
+		t.Errorf("Explain = %q", text)
+	}
+	if req.Model != "test-model" || len(req.Messages) != 2 || req.Messages[0].Role != "system" {
+		t.Errorf("unexpected request: %+v", *req)
+	}
+	if !strings.Contains(req.Messages[1].Content, "egg, 100 g: 155 kcal") {
+		t.Errorf("prompt does not describe the meal: %q", req.Messages[1].Content)
+	}
+	if got := header.Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("Authorization = %q", got)
+	}
+}
+
+func TestOpenAIAnalyzeUsesModelSuggestions(t *testing.T) {
+	srv, _, _ := modelServer(t, http.StatusOK, "Sure:\n```json\n[\"Add spinach\", \"Use whole-grain toast\"]\n```")
+	p := newTestProvider(srv.URL)
+
+	analysis, err := p.AnalyzeNutrition(context.Background(), testMeal, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"Add spinach", "Use whole-grain toast"}
+	if !reflect.DeepEqual(analysis.Recommendations, want) {
+		t.Errorf("Recommendations = %q, want %q", analysis.Recommendations, want)
+	}
+	if analysis.HealthScore == 0 {
+		t.Error("health score from the rules engine is missing")
+	}
+}
+
+func TestOpenAIRecommendRanksCandidates(t *testing.T) {
+	srv, _, _ := modelServer(t, http.StatusOK, `["shakshuka", "Omelette", "Pancakes"]`)
+	p := newTestProvider(srv.URL)
+
+	recipes, err := p.RecommendRecipes(context.Background(), []string{"egg", "tomato"}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unknown names are ignored and unlisted candidates keep their order
+	// after the ranked ones.
+	want := []string{"Shakshuka", "Omelette", "Tomato Salad", "Egg Fried Rice"}
+	if got := recipeNames(recipes); !reflect.DeepEqual(got, want) {
+		t.Errorf("RecommendRecipes = %q, want %q", got, want)
+	}
+}
+
+func TestOpenAIFallsBackOnServerError(t *testing.T) {
+	srv, _, _ := modelServer(t, http.StatusInternalServerError, "model not loaded")
+	p := newTestProvider(srv.URL)
+	ctx := context.Background()
+
+	recipes, err := p.RecommendRecipes(ctx, []string{"egg"}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := recipeNames(recipes), recipeNames(testCandidates); !reflect.DeepEqual(got, want) {
+		t.Errorf("RecommendRecipes = %q, want rule-based order %q", got, want)
+	}
+
+	analysis, err := p.AnalyzeNutrition(ctx, testMeal, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, _ := NewRulesProvider(testCandidates).AnalyzeNutrition(ctx, testMeal, nil)
+	if !reflect.DeepEqual(analysis.Recommendations, rules.Recommendations) {
+		t.Errorf("Recommendations = %q, want rule-based %q", analysis.Recommendations, rules.Recommendations)
+	}
+
+	text, err := p.Explain(ctx, testMeal, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != describeAnalysis(rules) {
+		t.Errorf("Explain = %q, want rule-based explanation", text)
+	}
+}
+
+func TestOpenAIFallsBackOnUnparsableList(t *testing.T) {
+	srv, _, _ := modelServer(t, http.StatusOK, "I would pick the omelette.")
+	p := newTestProvider(srv.URL)
+
+	recipes, err := p.RecommendRecipes(context.Background(), []string{"egg"}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := recipeNames(recipes), recipeNames(testCandidates); !reflect.DeepEqual(got, want) {
+		t.Errorf("RecommendRecipes = %q, want rule-based order %q", got, want)
+	}
+}
+
+func TestOpenAIFallsBackWhenUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+	p := newTestProvider(url)
+
+	text, err := p.Explain(context.Background(), testMeal, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(text, "This meal has a health score of") {
+		t.Errorf("Explain = %q, want rule-based explanation", text)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package ai
+
+import (
+	"FoodStats/internal/config"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Provider is the backend behind the AI endpoints.
+type Provider interface {
+	RecommendRecipes(ctx context.Context, ingredients []string, limit int) ([]config.Recipe, error)
+	AnalyzeNutrition(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (*config.NutritionAnalysis, error)
+	Explain(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (string, error)
+}
+
+// NewProvider builds the provider named by kind: "rules" (default),
+// "python" or "openai". The recommender serves the Go providers.
+func NewProvider(kind string, recommender Recommender) (Provider, error) {
+	switch kind {
+	case "rules", "":
+		return NewRulesProvider(recommender), nil
+	case "python":
+		return NewPythonProvider(), nil
+	case "openai":
+		rules := NewRulesProvider(recommender)
+		return NewOpenAIProvider(config.GetAIBaseURL(), config.GetAIModel(), config.GetAIAPIKey(), rules), nil
+	default:
+		return nil, fmt.Errorf("unknown AI provider: %s", kind)
+	}
+}
+
+// describeAnalysis turns an analysis into a short plain-text explanation.
+func describeAnalysis(analysis *config.NutritionAnalysis) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "This meal has a health score of %.1f out of 100.", analysis.HealthScore)
+
+	balance := analysis.NutrientBalance
+	if balance["Proteins"]+balance["Carbs"]+balance["Fats"] > 0 {
+		fmt.Fprintf(&b, " By weight, its macronutrients are %.0f%% protein, %.0f%% carbohydrate and %.0f%% fat.",
+			balance["Proteins"]*100, balance["Carbs"]*100, balance["Fats"]*100)
+	}
+
+	if goal, ok := analysis.UserData["daily_calorie_goal"].(float64); ok && goal > 0 {
+		pct, _ := analysis.UserData["meal_percentage"].(float64)
+		fmt.Fprintf(&b, " It covers %.1f%% of your daily goal of %.0f kcal.", pct, goal)
+	}
+
+	if len(analysis.Recommendations) > 0 {
+		b.WriteString(" Suggestions: ")
+		b.WriteString(strings.Join(analysis.Recommendations, "; "))
+		b.WriteString(".")
+	}
+	return b.String()
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package ai
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/nutrition"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+// PythonProvider runs recommendations and, with ANALYZER=python, nutrition
+// analysis through the scripts in internal/mls.
+type PythonProvider struct {
+	pythonPath string
+	mlsPath    string
+	analyzer   string
+	pool       *Pool
+}
+
+func detectPythonExecutable() string {
+	var pythonPath string
+	var err error
+
+	if runtime.GOOS == "windows" {
+		pythonPath, err = exec.LookPath("python")
+		if err == nil {
+			return pythonPath
+		}
+	} else {
+		pythonPath, err = exec.LookPath("python3")
+		if err == nil {
+			return pythonPath
+		}
+	}
+
+	return "python"
+}
+
+func NewPythonProvider() *PythonProvider {
+	isRender := os.Getenv("RENDER") == "true"
+
+	var mlsPath string
+	if isRender {
+		candidates := []string{
+			filepath.Join("internal", "mls"),
+			"/app/internal/mls",
+			".",
+			".",
+			"./backend/internal/mls",
+			"../internal/mls",
+			"/home/runner/FoodStats/internal/mls",
+			"/home/runner/FoodStats/backend/internal/mls",
+		}
+
+		for _, path := range candidates {
+			if _, err := os.Stat(path); err == nil {
+				if _, err := os.Stat(filepath.Join(path, "analyzer.py")); err == nil {
+					mlsPath = path
+					break
+				}
+			}
+		}
+
+		if mlsPath == "" {
+			mlsPath = "/app/internal/mls"
+		}
+	} else {
+		mlsPath = filepath.Join("internal", "mls")
+	}
+
+	s := &PythonProvider{
+		pythonPath: detectPythonExecutable(),
+		mlsPath:    mlsPath,
+		analyzer:   config.GetAnalyzer(),
+	}
+	s.pool = NewPool(s.pythonPath, s.findScript("worker.py"), config.GetPythonWorkers(), config.GetAITimeout())
+	return s
+}
+
+// findScript locates a script from internal/mls, trying the configured
+// directory first and then the layouts used by dev, desktop and Render builds.
+func (s *PythonProvider) findScript(name string) string {
+	candidates := []string{
+		filepath.Join(s.mlsPath, name),
+		filepath.Join("backend", "internal", "mls", name),
+		name,
+		filepath.Join("internal", "mls", name),
+		filepath.Join("..", "internal", "mls", name),
+		filepath.Join("/opt/render/project/src/internal/mls", name),
+	}
+
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return candidates[0]
+}
+
+func (s *PythonProvider) RecommendRecipes(ctx context.Context, ingredients []string, limit int) ([]config.Recipe, error) {
+	params := map[string]interface{}{
+		"ingredients": ingredients,
+		"top_k":       limit,
+	}
+
+	var recipes []config.Recipe
+	if err := s.pool.Call(ctx, "recommend", params, &recipes); err != nil {
+		return nil, fmt.Errorf("AI recommendation error: %w", err)
+	}
+	return recipes, nil
+}
+
+func (s *PythonProvider) AnalyzeNutrition(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (*config.NutritionAnalysis, error) {
+	if s.analyzer == "python" {
+		analysis, err := s.analyzeWithPython(ctx, ingredients, profile)
+		if err == nil {
+			return analysis, nil
+		}
+		log.Printf("Python analyzer failed, using native analysis: %v", err)
+	}
+
+	analysis := nutrition.Analyze(ingredients, profile)
+	return &analysis, nil
+}
+
+func (s *PythonProvider) analyzeWithPython(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (*config.NutritionAnalysis, error) {
+	params := map[string]interface{}{
+		"ingredients":  ingredients,
+		"user_profile": profile,
+	}
+
+	var analysis config.NutritionAnalysis
+	if err := s.pool.Call(ctx, "analyze", params, &analysis); err != nil {
+		return nil, fmt.Errorf("nutrition analysis error: %w", err)
+	}
+	return &analysis, nil
+}
+
+func (s *PythonProvider) Explain(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (string, error) {
+	analysis, err := s.AnalyzeNutrition(ctx, ingredients, profile)
+	if err != nil {
+		return "", err
+	}
+	return describeAnalysis(analysis), nil
+}
+
+func (s *PythonProvider) Close() error {
+	s.pool.Close()
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package ai
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/nutrition"
+	"context"
+)
+
+// Recommender ranks recipes for a set of available ingredients.
+type Recommender interface {
+	Recommend(available []string, k int) []config.Recipe
+}
+
+// RulesProvider answers every request in Go without Python or a model
+// server: recipes come from the TF-IDF recommender and analysis uses the
+// native scoring engine.
+type RulesProvider struct {
+	recommender Recommender
+}
+
+func NewRulesProvider(recommender Recommender) *RulesProvider {
+	return &RulesProvider{recommender: recommender}
+}
+
+func (p *RulesProvider) RecommendRecipes(ctx context.Context, ingredients []string, limit int) ([]config.Recipe, error) {
+	return p.recommender.Recommend(ingredients, limit), nil
+}
+
+func (p *RulesProvider) AnalyzeNutrition(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (*config.NutritionAnalysis, error) {
+	analysis := nutrition.Analyze(ingredients, profile)
+	return &analysis, nil
+}
+
+func (p *RulesProvider) Explain(ctx context.Context, ingredients []config.Ingredient, profile *config.UserProfile) (string, error) {
+	analysis, err := p.AnalyzeNutrition(ctx, ingredients, profile)
+	if err != nil {
+		return "", err
+	}
+	return describeAnalysis(analysis), nil
+}
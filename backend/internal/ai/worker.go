@@ -0,0 +1,292 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package ai
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	ErrWorkerExited = errors.New("python worker exited")
+	ErrPoolClosed   = errors.New("python worker pool closed")
+)
+
+const (
+	maxResponseSize = 16 << 20
+	restartBackoff  = 5 * time.Second
+	stopGracePeriod = 2 * time.Second
+)
+
+type rpcRequest struct {
+	ID     uint64      `json:"id"`
+	Method string      `json:"method"`
+	Params interface{} `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+// worker is a single worker.py process. Requests are written one JSON
+// object per line and matched to responses by ID.
+type worker struct {
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	pending map[uint64]chan rpcResponse
+	nextID  uint64
+	done    chan struct{}
+}
+
+func startWorker(python, script string) (*worker, error) {
+	cmd := exec.Command(python, "-u", script)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting python worker failed: %w", err)
+	}
+
+	w := &worker{
+		cmd:     cmd,
+		stdin:   stdin,
+		pending: make(map[uint64]chan rpcResponse),
+		done:    make(chan struct{}),
+	}
+	go w.readLoop(stdout)
+	return w, nil
+}
+
+func (w *worker) readLoop(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), maxResponseSize)
+	for scanner.Scan() {
+		var resp rpcResponse
+		if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			log.Printf("Discarding malformed python worker output: %v", err)
+			continue
+		}
+		w.mu.Lock()
+		ch, ok := w.pending[resp.ID]
+		delete(w.pending, resp.ID)
+		w.mu.Unlock()
+		if ok {
+			ch <- resp
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("Reading python worker output failed: %v", err)
+		w.cmd.Process.Kill()
+	}
+
+	err := w.cmd.Wait()
+	log.Printf("Python worker %d exited: %v", w.cmd.Process.Pid, err)
+	close(w.done)
+}
+
+func (w *worker) alive() bool {
+	select {
+	case <-w.done:
+		return false
+	default:
+		return true
+	}
+}
+
+func (w *worker) call(ctx context.Context, method string, params, result interface{}) error {
+	id := atomic.AddUint64(&w.nextID, 1)
+	line, err := json.Marshal(rpcRequest{ID: id, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+
+	ch := make(chan rpcResponse, 1)
+	w.mu.Lock()
+	w.pending[id] = ch
+	w.mu.Unlock()
+	defer func() {
+		w.mu.Lock()
+		delete(w.pending, id)
+		w.mu.Unlock()
+	}()
+
+	w.writeMu.Lock()
+	_, err = w.stdin.Write(append(line, '\n'))
+	w.writeMu.Unlock()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrWorkerExited, err)
+	}
+
+	select {
+	case resp := <-ch:
+		if resp.Error != "" {
+			return errors.New(resp.Error)
+		}
+		if result == nil {
+			return nil
+		}
+		if err := json.Unmarshal(resp.Result, result); err != nil {
+			return fmt.Errorf("failed to parse %s result: %v", method, err)
+		}
+		return nil
+	case <-w.done:
+		return ErrWorkerExited
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *worker) stop() {
+	w.stdin.Close()
+	select {
+	case <-w.done:
+	case <-time.After(stopGracePeriod):
+		w.cmd.Process.Kill()
+		<-w.done
+	}
+}
+
+// Pool runs a fixed number of worker.py processes. Each process handles one
+// request at a time, so the pool size also bounds concurrency; callers wait
+// for an idle worker. Workers are started on first use, health-checked on
+// start and restarted after they exit or time out.
+type Pool struct {
+	python  string
+	script  string
+	timeout time.Duration
+
+	idle chan int
+
+	mu          sync.Mutex
+	workers     []*worker
+	closed      bool
+	lastFailure time.Time
+	lastErr     error
+}
+
+func NewPool(python, script string, size int, timeout time.Duration) *Pool {
+	if size < 1 {
+		size = 1
+	}
+	p := &Pool{
+		python:  python,
+		script:  script,
+		timeout: timeout,
+		idle:    make(chan int, size),
+		workers: make([]*worker, size),
+	}
+	for i := 0; i < size; i++ {
+		p.idle <- i
+	}
+	return p
+}
+
+// Call runs method on an idle worker. The request is bounded by both ctx and
+// the pool timeout; a worker that misses the pool timeout is killed.
+func (p *Pool) Call(ctx context.Context, method string, params, result interface{}) error {
+	callCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	var slot int
+	select {
+	case slot = <-p.idle:
+	case <-callCtx.Done():
+		return callCtx.Err()
+	}
+	defer func() { p.idle <- slot }()
+
+	w, err := p.worker(callCtx, slot)
+	if err != nil {
+		return err
+	}
+
+	err = w.call(callCtx, method, params, result)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		log.Printf("Python worker timed out on %s after %s, restarting it", method, p.timeout)
+		w.cmd.Process.Kill()
+	}
+	return err
+}
+
+// worker returns the process in slot, starting it if needed. The caller
+// holds the slot, so the start and health check, which can take as long as
+// loading the models, run without the pool lock.
+func (p *Pool) worker(ctx context.Context, slot int) (*worker, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+	if w := p.workers[slot]; w != nil && w.alive() {
+		p.mu.Unlock()
+		return w, nil
+	}
+	if p.lastErr != nil && time.Since(p.lastFailure) < restartBackoff {
+		err := p.lastErr
+		p.mu.Unlock()
+		return nil, err
+	}
+	p.mu.Unlock()
+
+	w, err := startWorker(p.python, p.script)
+	if err == nil {
+		err = w.call(ctx, "health", nil, nil)
+		if err != nil {
+			w.cmd.Process.Kill()
+			err = fmt.Errorf("python worker health check failed: %w", err)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err != nil {
+		p.lastFailure, p.lastErr = time.Now(), err
+		return nil, err
+	}
+	if p.closed {
+		w.stop()
+		return nil, ErrPoolClosed
+	}
+
+	p.lastErr = nil
+	p.workers[slot] = w
+	return w, nil
+}
+
+func (p *Pool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for i, w := range p.workers {
+		if w != nil {
+			w.stop()
+			p.workers[i] = nil
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package ai
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowWorker stands in for worker.py: it takes startDelay to come up, as
+// the real one does while loading its model, then echoes params back.
+const slowWorker = `
+import sys, json, time
+time.sleep(0.5)
+for line in sys.stdin:
+    req = json.loads(line)
+    print(json.dumps({"id": req["id"], "result": req.get("params")}), flush=True)
+`
+
+const startDelay = 500 * time.Millisecond
+
+func newTestPool(t *testing.T, size int) *Pool {
+	t.Helper()
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 not installed")
+	}
+	script := filepath.Join(t.TempDir(), "worker.py")
+	if err := os.WriteFile(script, []byte(slowWorker), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pool := NewPool(python, script, size, 10*time.Second)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestPoolStartsWorkersConcurrently(t *testing.T) {
+	const size = 3
+	pool := newTestPool(t, size)
+
+	began := time.Now()
+	var wg sync.WaitGroup
+	errs := make(chan error, size)
+	for i := 0; i < size; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			var got int
+			if err := pool.Call(context.Background(), "echo", n, &got); err != nil {
+				errs <- err
+				return
+			}
+			if got != n {
+				t.Errorf("echo(%d) = %d", n, got)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// Started one after another, the workers would take size*startDelay.
+	if elapsed := time.Since(began); elapsed >= size*startDelay {
+		t.Errorf("starting %d workers took %s; they were started one at a time", size, elapsed)
+	}
+}
+
+func TestPoolCloseStopsWorkers(t *testing.T) {
+	pool := newTestPool(t, 1)
+	if err := pool.Call(context.Background(), "echo", 1, nil); err != nil {
+		t.Fatal(err)
+	}
+	pool.Close()
+	if err := pool.Call(context.Background(), "echo", 1, nil); err != ErrPoolClosed {
+		t.Fatalf("Call after Close = %v, want %v", err, ErrPoolClosed)
+	}
+}
@@ -6,12 +6,16 @@
 package api
 
 import (
+	"FoodStats/internal/ai"
 	handler "FoodStats/internal/api/handlers"
 	"FoodStats/internal/api/middleware"
 	"FoodStats/internal/config"
 	"FoodStats/internal/database"
+	"FoodStats/internal/recommend"
+	"FoodStats/internal/search"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
@@ -50,16 +54,61 @@ func NewRouter(logger zerolog.Logger) *mux.Router {
 	apiRouter.HandleFunc("/reset", handler.ResetHandler).Methods(http.MethodDelete, http.MethodOptions)
 	apiRouter.HandleFunc("/ingredients", handler.ListIngredientsHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/deleteingredient", handler.DeleteIngredientHandler).Methods(http.MethodDelete, http.MethodOptions)
+	apiRouter.HandleFunc("/nutrients", handler.ListNutrientsHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/units", handler.ListUnitsHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/allergens", handler.ListAllergensHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/ingredientinfo", handler.IngredientInfoHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/products/{barcode}", handler.GetProductHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/prices/{name}", handler.PriceHistoryHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/suggestions", handler.SuggestionHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/listrecipes", handler.ListRecipesHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/getrecipe", handler.GetRecipeHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/addrecipe", handler.AddRecipeHandler).Methods(http.MethodPost, http.MethodOptions)
+	apiRouter.HandleFunc("/recipes/cheapest", handler.CheapestRecipesHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/recipes/{id:[0-9]+}", handler.GetRecipeByIDHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/recipes/{id:[0-9]+}", handler.UpdateRecipeHandler).Methods(http.MethodPut, http.MethodPatch, http.MethodOptions)
+	apiRouter.HandleFunc("/recipes/{id:[0-9]+}", handler.DeleteRecipeHandler).Methods(http.MethodDelete, http.MethodOptions)
+	apiRouter.HandleFunc("/recipes/{id:[0-9]+}/scale", handler.ScaleRecipeHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/suggestrecipes", handler.SuggestRecipesHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/analyzenutrition", handler.AnalyzeNutritionHandler).Methods(http.MethodPost, http.MethodOptions)
+	apiRouter.HandleFunc("/explainnutrition", handler.ExplainNutritionHandler).Methods(http.MethodPost, http.MethodOptions)
 	apiRouter.HandleFunc("/smartrecommendations", handler.SmartRecommendationsHandler).Methods(http.MethodPost, http.MethodOptions)
 	apiRouter.HandleFunc("/saveprofile", handler.SaveProfileHandler).Methods(http.MethodPost, http.MethodOptions)
 	apiRouter.HandleFunc("/getprofile", handler.GetProfileHandler).Methods(http.MethodGet, http.MethodOptions)
 	apiRouter.HandleFunc("/resetprofile", handler.ResetProfileHandler).Methods(http.MethodDelete, http.MethodOptions)
+	apiRouter.HandleFunc("/profilehistory", handler.ProfileHistoryHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/targets", handler.TargetsHandler).Methods(http.MethodGet, http.MethodOptions)
+
+	apiRouter.HandleFunc("/diary", handler.ListDiaryEntriesHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/diary", handler.AddDiaryEntryHandler).Methods(http.MethodPost, http.MethodOptions)
+	apiRouter.HandleFunc("/diary/summary", handler.DiarySummaryHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/diary/{id:[0-9]+}", handler.DeleteDiaryEntryHandler).Methods(http.MethodDelete, http.MethodOptions)
+
+	apiRouter.HandleFunc("/pantry", handler.ListPantryHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/pantry", handler.AddPantryItemHandler).Methods(http.MethodPost, http.MethodOptions)
+	apiRouter.HandleFunc("/pantry/consume", handler.ConsumePantryHandler).Methods(http.MethodPost, http.MethodOptions)
+	apiRouter.HandleFunc("/pantry/{id:[0-9]+}", handler.DeletePantryItemHandler).Methods(http.MethodDelete, http.MethodOptions)
+	apiRouter.HandleFunc("/shoppinglist", handler.ShoppingListHandler).Methods(http.MethodPost, http.MethodOptions)
+
+	apiRouter.HandleFunc("/mealplans", handler.ListMealPlansHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/mealplans", handler.GenerateMealPlanHandler).Methods(http.MethodPost, http.MethodOptions)
+	apiRouter.HandleFunc("/mealplans/{id:[0-9]+}", handler.GetMealPlanHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/mealplans/{id:[0-9]+}", handler.DeleteMealPlanHandler).Methods(http.MethodDelete, http.MethodOptions)
+	apiRouter.HandleFunc("/mealplans/{id:[0-9]+}/regenerate", handler.RegenerateMealPlanHandler).Methods(http.MethodPost, http.MethodOptions)
+	apiRouter.HandleFunc("/mealplans/{id:[0-9]+}/shoppinglist", handler.MealPlanShoppingListHandler).Methods(http.MethodGet, http.MethodOptions)
+	apiRouter.HandleFunc("/mealplans/{id:[0-9]+}/days/{day:[0-9]+}/{meal}", handler.UpdatePlannedMealHandler).Methods(http.MethodPut, http.MethodOptions)
+	apiRouter.HandleFunc("/mealplans/{id:[0-9]+}/days/{day:[0-9]+}/{meal}/regenerate", handler.RegenerateMealHandler).Methods(http.MethodPost, http.MethodOptions)
+
+	adminRouter := apiRouter.PathPrefix("/admin").Subrouter()
+	adminRouter.Use(middleware.AdminAuth(config.GetAdminToken()))
+	adminRouter.HandleFunc("/ingredients", handler.CreateCatalogIngredientHandler).Methods(http.MethodPost, http.MethodOptions)
+	adminRouter.HandleFunc("/ingredients/bulk", handler.BulkUpsertIngredientsHandler).Methods(http.MethodPost, http.MethodPut, http.MethodOptions)
+	adminRouter.HandleFunc("/ingredients/audit", handler.IngredientAuditHandler).Methods(http.MethodGet, http.MethodOptions)
+	adminRouter.HandleFunc("/ingredients/{name}", handler.GetCatalogIngredientHandler).Methods(http.MethodGet, http.MethodOptions)
+	adminRouter.HandleFunc("/ingredients/{name}", handler.UpdateCatalogIngredientHandler).Methods(http.MethodPut, http.MethodOptions)
+	adminRouter.HandleFunc("/ingredients/{name}", handler.DeleteCatalogIngredientHandler).Methods(http.MethodDelete, http.MethodOptions)
+	adminRouter.HandleFunc("/prices", handler.AddPriceHandler).Methods(http.MethodPost, http.MethodOptions)
+	adminRouter.HandleFunc("/prices/{id:[0-9]+}", handler.DeletePriceHandler).Methods(http.MethodDelete, http.MethodOptions)
 
 	staticFs := http.FileServer(http.Dir("../frontend"))
 	r.PathPrefix("/").Handler(staticFs)
@@ -82,9 +131,50 @@ func InitServer() {
 	}
 	defer database.CloseDB()
 
+	store, err := database.NewSessionStore(config.GetSessionStore())
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize session store")
+	}
+	handler.SetSessionStore(store)
+
+	profiles, err := database.NewProfileRepository(database.DB)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize profile storage")
+	}
+	handler.SetProfileRepository(profiles)
+
+	pantry, err := database.NewPantryRepository(database.DB)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize pantry storage")
+	}
+	handler.SetPantryRepository(pantry)
+
+	index, err := recommend.BuildIndex()
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to build recipe recommender index")
+	}
+	database.OnRecipeChange(index.Sync)
+
+	ingredients, err := search.BuildIndex()
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to build ingredient search index")
+	}
+	database.OnIngredientChange(func() {
+		if err := ingredients.Reload(); err != nil {
+			logger.Error().Err(err).Msg("Failed to refresh ingredient search index")
+		}
+	})
+	handler.SetSearchIndex(ingredients)
+
+	provider, err := ai.NewProvider(config.GetAIProvider(), index)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to initialize AI provider")
+	}
+	handler.SetAIProvider(provider)
+
 	r := NewRouter(logger)
 
-	config.CleanupSessions()
+	config.CleanupSessions(store)
 
 	srv := &http.Server{
 		Handler:      r,
@@ -105,6 +195,9 @@ func InitServer() {
 		if err := srv.Shutdown(ctx); err != nil {
 			logger.Error().Err(err).Msg("Server shutdown error")
 		}
+		if closer, ok := provider.(io.Closer); ok {
+			closer.Close()
+		}
 	}()
 
 	logger.Info().Msgf("Server starting on port %s", config.GetPort())
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	maxBulkIngredients = 1000
+	defaultAuditLimit  = 50
+	maxAuditLimit      = 500
+
+	// adminSource marks rows written through the admin API when the
+	// request does not name a source.
+	adminSource = "admin"
+)
+
+func catalogErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, database.ErrIngredientNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, database.ErrIngredientInUse), errors.Is(err, database.ErrCatalogDuplicate):
+		return http.StatusConflict
+	case errors.Is(err, database.ErrInvalidIngredient):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// adminActor names the person making a change in the audit log. The admin
+// token is shared, so clients identify themselves with X-Admin-User.
+func adminActor(r *http.Request) string {
+	if user := strings.TrimSpace(r.Header.Get("X-Admin-User")); user != "" && len(user) <= 100 {
+		return user
+	}
+	return "admin"
+}
+
+// checkEnergy reports whether the Atwater energy check applies. ?force=true
+// skips it for foods the factors do not fit.
+func checkEnergy(r *http.Request) bool {
+	force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+	return !force
+}
+
+func GetCatalogIngredientHandler(w http.ResponseWriter, r *http.Request) {
+	rec, err := database.GetIngredientRecord(mux.Vars(r)["name"])
+	if err != nil {
+		http.Error(w, "Failed to fetch ingredient: "+err.Error(), catalogErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(rec)
+}
+
+func CreateCatalogIngredientHandler(w http.ResponseWriter, r *http.Request) {
+	var rec config.IngredientRecord
+	if err := json.NewDecoder(r.Body).Decode(&rec); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	if rec.Source == "" {
+		rec.Source = adminSource
+	}
+	if err := database.CreateIngredient(rec, adminActor(r), checkEnergy(r)); err != nil {
+		http.Error(w, "Failed to create ingredient: "+err.Error(), catalogErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": "Ingredient created"})
+}
+
+func UpdateCatalogIngredientHandler(w http.ResponseWriter, r *http.Request) {
+	var rec config.IngredientRecord
+	if err := json.NewDecoder(r.Body).Decode(&rec); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	rec.Name = mux.Vars(r)["name"]
+	if rec.Source == "" {
+		rec.Source = adminSource
+	}
+
+	if err := database.UpdateIngredient(rec, adminActor(r), checkEnergy(r)); err != nil {
+		http.Error(w, "Failed to update ingredient: "+err.Error(), catalogErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": "Ingredient updated"})
+}
+
+func DeleteCatalogIngredientHandler(w http.ResponseWriter, r *http.Request) {
+	if err := database.DeleteIngredient(mux.Vars(r)["name"], adminActor(r)); err != nil {
+		http.Error(w, "Failed to delete ingredient: "+err.Error(), catalogErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": "Ingredient deleted"})
+}
+
+func BulkUpsertIngredientsHandler(w http.ResponseWriter, r *http.Request) {
+	var records []config.IngredientRecord
+	if err := json.NewDecoder(r.Body).Decode(&records); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	if len(records) == 0 || len(records) > maxBulkIngredients {
+		http.Error(w, "Send between 1 and "+strconv.Itoa(maxBulkIngredients)+" ingredients", http.StatusBadRequest)
+		return
+	}
+
+	for i := range records {
+		if records[i].Source == "" {
+			records[i].Source = adminSource
+		}
+	}
+	result, err := database.UpsertIngredients(records, database.UpsertOptions{
+		Actor:       adminActor(r),
+		CheckEnergy: checkEnergy(r),
+	})
+	if err != nil {
+		http.Error(w, "Failed to save ingredients: "+err.Error(), catalogErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func IngredientAuditHandler(w http.ResponseWriter, r *http.Request) {
+	limit := defaultAuditLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxAuditLimit {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	entries, err := database.ListIngredientAudit(r.URL.Query().Get("ingredient"), limit)
+	if err != nil {
+		http.Error(w, "Failed to fetch audit log", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(entries)
+}
@@ -8,11 +8,26 @@ package handlers
 import (
 	"FoodStats/internal/ai"
 	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"FoodStats/internal/nutrition"
+	"FoodStats/internal/recommend"
 	"encoding/json"
+	"log"
+	"math"
 	"net/http"
+	"time"
 )
 
-var aiService = ai.NewAIService()
+const (
+	maxRecommendations = 5
+	candidatePoolSize  = 30
+)
+
+var aiProvider ai.Provider
+
+func SetAIProvider(provider ai.Provider) {
+	aiProvider = provider
+}
 
 func SmartRecommendationsHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -37,15 +52,55 @@ func SmartRecommendationsHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	} else {
+		sessionID := config.GetSessionID(w, r)
+		prefs, hasProfile := recommendationPreferences(sessionID)
 
-		recommendations, err := aiService.GetRecipeRecommendations(req.Ingredients)
+		limit := maxRecommendations
+		if hasProfile {
+			limit = candidatePoolSize
+		}
+
+		recommendations, err := aiProvider.RecommendRecipes(r.Context(), req.Ingredients, limit)
 		if err != nil {
 			http.Error(w, "Failed to get recommendations: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
 
-		json.NewEncoder(w).Encode(recommendations)
+		json.NewEncoder(w).Encode(recommend.Personalize(recommendations, prefs, maxRecommendations))
+	}
+}
+
+// recommendationPreferences gathers the caller's restrictions, allergies,
+// targets and what they have logged in the diary today.
+func recommendationPreferences(sessionID string) (recommend.Preferences, bool) {
+	var prefs recommend.Preferences
+
+	profile, exists, err := profiles.Get(sessionID)
+	if err != nil {
+		log.Printf("Error loading profile: %v", err)
+		return prefs, false
+	}
+	if !exists {
+		return prefs, false
+	}
+
+	prefs.Restrictions = profile.DietaryRestrictions
+	prefs.Allergies = profile.Allergies
+
+	targets, err := nutrition.ComputeTargets(profile, nutrition.MifflinStJeor)
+	if err != nil {
+		log.Printf("Error computing targets: %v", err)
+		return prefs, true
 	}
+	prefs.Targets = &targets
+
+	summary, err := database.DiaryDaySummary(sessionID, time.Now().Format("2006-01-02"))
+	if err != nil {
+		log.Printf("Error loading diary summary: %v", err)
+		return prefs, true
+	}
+	prefs.Eaten = summary.Total.NutritionalInfo
+	return prefs, true
 }
 
 func AnalyzeNutritionHandler(w http.ResponseWriter, r *http.Request) {
@@ -61,25 +116,42 @@ func AnalyzeNutritionHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	sessionID := config.GetSessionID(w, r)
-	profile, hasProfile := userProfiles[sessionID]
+	profile, hasProfile, err := profiles.Get(sessionID)
+	if err != nil {
+		log.Printf("Error loading profile: %v", err)
+		hasProfile = false
+	}
+
+	var targets *config.NutritionTargets
+	if hasProfile {
+		t, err := nutrition.ComputeTargets(profile, nutrition.MifflinStJeor)
+		if err != nil {
+			log.Printf("Error computing targets: %v", err)
+		} else {
+			targets = &t
+		}
+	}
 
 	var analysis *config.NutritionAnalysis
-	var err error
 
 	if hasProfile {
-		analysis, err = aiService.AnalyzeNutrition(ingredients, &profile)
+		analysis, err = aiProvider.AnalyzeNutrition(r.Context(), ingredients, &profile)
 	} else {
-		analysis, err = aiService.AnalyzeNutrition(ingredients, nil)
+		analysis, err = aiProvider.AnalyzeNutrition(r.Context(), ingredients, nil)
 	}
 
 	if err != nil {
 		var totalCalories, totalProteins, totalCarbs, totalFats, totalFiber float64
+		var totalNutrients config.Nutrients
+		var missingNutrients []string
 		for _, ing := range ingredients {
 			totalCalories += ing.Calories
 			totalProteins += ing.Proteins
 			totalCarbs += ing.Carbs
 			totalFats += ing.Fats
 			totalFiber += ing.Fiber
+			totalNutrients.Add(ing.Nutrients)
+			missingNutrients = append(missingNutrients, ing.MissingNutrients...)
 		}
 		resp := map[string]interface{}{
 			"health_score":      nil,
@@ -94,6 +166,16 @@ func AnalyzeNutritionHandler(w http.ResponseWriter, r *http.Request) {
 				"fats":     totalFats,
 				"fiber":    totalFiber,
 			},
+			"nutrients":         totalNutrients,
+			"missing_nutrients": missingNutrients,
+		}
+		if targets != nil {
+			resp["targets"] = targets
+			resp["user_data"] = map[string]float64{
+				"daily_calorie_goal": targets.Calories,
+				"meal_percentage":    math.Round(totalCalories/targets.Calories*1000) / 10,
+				"tdee":               targets.TDEE,
+			}
 		}
 		w.Header().Set("Content-Type", "application/json")
 		err = json.NewEncoder(w).Encode(resp)
@@ -104,6 +186,8 @@ func AnalyzeNutritionHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	analysis.Targets = targets
+
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(analysis)
 	if err != nil {
@@ -111,3 +195,38 @@ func AnalyzeNutritionHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func ExplainNutritionHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var ingredients []config.Ingredient
+	if err := json.NewDecoder(r.Body).Decode(&ingredients); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	var profile *config.UserProfile
+	if p, exists, err := profiles.Get(sessionID); err != nil {
+		log.Printf("Error loading profile: %v", err)
+	} else if exists {
+		profile = &p
+	}
+
+	explanation, err := aiProvider.Explain(r.Context(), ingredients, profile)
+	if err != nil {
+		http.Error(w, "Failed to explain analysis: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"explanation": explanation})
+}
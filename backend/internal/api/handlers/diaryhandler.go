@@ -0,0 +1,199 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+func diaryDate(r *http.Request) (string, bool) {
+	date := r.URL.Query().Get("date")
+	if date == "" {
+		return time.Now().Format("2006-01-02"), true
+	}
+	return date, database.ValidateDate(date)
+}
+
+func AddDiaryEntryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input struct {
+		Date        string            `json:"date"`
+		Meal        string            `json:"meal"`
+		RecipeID    int               `json:"recipe_id"`
+		Portion     float64           `json:"portion"`
+		Ingredients []ingredientInput `json:"ingredients"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	if input.Date == "" {
+		input.Date = time.Now().Format("2006-01-02")
+	}
+	input.Meal = strings.ToLower(strings.TrimSpace(input.Meal))
+	if !database.ValidateDate(input.Date) || !database.ValidateMeal(input.Meal) {
+		http.Error(w, "Invalid date or meal type", http.StatusBadRequest)
+		return
+	}
+
+	if (input.RecipeID > 0) == (len(input.Ingredients) > 0) {
+		http.Error(w, "Provide either a recipe or a list of ingredients", http.StatusBadRequest)
+		return
+	}
+
+	entry := config.DiaryEntry{Date: input.Date, Meal: input.Meal}
+
+	if input.RecipeID > 0 {
+		recipe, err := database.GetRecipeByID(input.RecipeID)
+		if err != nil {
+			http.Error(w, "Recipe not found", recipeErrorStatus(err))
+			return
+		}
+		if input.Portion == 0 {
+			input.Portion = 1
+		}
+		if input.Portion < 0 || input.Portion > 50 {
+			http.Error(w, "Invalid portion", http.StatusBadRequest)
+			return
+		}
+		scaled := recipe.Scaled(input.Portion / float64(recipe.Servings))
+		entry.RecipeID = recipe.ID
+		entry.RecipeName = recipe.Name
+		entry.Portion = input.Portion
+		entry.Ingredients = scaled.Ingredients
+	} else {
+		for _, in := range input.Ingredients {
+			ingredient, inputErr := resolveIngredient(in)
+			if inputErr != nil {
+				http.Error(w, inputErr.message+": "+in.Name+in.Text, inputErr.status)
+				return
+			}
+			entry.Ingredients = append(entry.Ingredients, ingredient)
+		}
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	entry, err := database.AddDiaryEntry(sessionID, entry)
+	if err != nil {
+		log.Printf("Error saving diary entry: %v", err)
+		http.Error(w, "Failed to save diary entry", http.StatusInternalServerError)
+		return
+	}
+	consumeRecipeFromPantry(sessionID, entry)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(entry)
+}
+
+func ListDiaryEntriesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	date, ok := diaryDate(r)
+	if !ok {
+		http.Error(w, "Invalid date", http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	entries, err := database.ListDiaryEntries(sessionID, date)
+	if err != nil {
+		log.Printf("Error loading diary: %v", err)
+		http.Error(w, "Failed to load diary", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(entries)
+}
+
+func DeleteDiaryEntryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || id <= 0 {
+		http.Error(w, "Invalid diary entry id", http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	if err := database.DeleteDiaryEntry(sessionID, id); err != nil {
+		if errors.Is(err, database.ErrDiaryEntryNotFound) {
+			http.Error(w, "Diary entry not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to delete diary entry", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": "Diary entry deleted."})
+}
+
+func DiarySummaryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	date, ok := diaryDate(r)
+	if !ok {
+		http.Error(w, "Invalid date", http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	summary, err := database.DiaryDaySummary(sessionID, date)
+	if err != nil {
+		log.Printf("Error summarizing diary: %v", err)
+		http.Error(w, "Failed to summarize diary", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(summary)
+}
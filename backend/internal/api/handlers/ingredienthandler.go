@@ -8,13 +8,98 @@ package handlers
 import (
 	"FoodStats/internal/config"
 	"FoodStats/internal/database"
+	"FoodStats/internal/units"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
-	"sort"
 	"strings"
 )
 
+var sessionStore database.SessionStore = database.NewMemorySessionStore()
+
+func SetSessionStore(store database.SessionStore) {
+	sessionStore = store
+}
+
+type ingredientInput struct {
+	config.TemplateIngredient
+	Text string `json:"text"`
+}
+
+type inputError struct {
+	status  int
+	message string
+}
+
+func resolveIngredient(input ingredientInput) (config.Ingredient, *inputError) {
+	if input.Barcode != "" {
+		return resolveProduct(input.TemplateIngredient)
+	}
+	unmeasured := false
+	if input.Text != "" {
+		quantity, err := units.ParseLine(input.Text)
+		if err != nil {
+			return config.Ingredient{}, &inputError{http.StatusBadRequest, "Could not understand ingredient line"}
+		}
+		input.Name = quantity.Name
+		input.Quantity = quantity.Amount
+		input.Unit = quantity.Unit.Name
+		unmeasured = quantity.Unmeasured
+	}
+
+	if input.Name == "" || (input.Grams <= 0 && input.Quantity <= 0) {
+		return config.Ingredient{}, &inputError{http.StatusBadRequest, "Invalid input"}
+	}
+	input.Name = strings.TrimSpace(input.Name)
+	input.Name = strings.ToLower(input.Name)
+
+	resolved, err := database.ResolveQuantity(input.TemplateIngredient)
+	if err != nil && unmeasured && !errors.Is(err, database.ErrIngredientNotFound) {
+		// A bare "salt" has no piece weight; count it as a pinch.
+		input.Unit = "pinch"
+		resolved, err = database.ResolveQuantity(input.TemplateIngredient)
+	}
+	if err != nil {
+		if errors.Is(err, database.ErrIngredientNotFound) {
+			return config.Ingredient{}, &inputError{http.StatusNotFound, "Unknown ingredient"}
+		}
+		return config.Ingredient{}, &inputError{http.StatusBadRequest, "Invalid quantity: " + err.Error()}
+	}
+	if !database.ValidateGrams(resolved.Grams) {
+		return config.Ingredient{}, &inputError{http.StatusBadRequest, "Invalid input"}
+	}
+
+	ingredient, err := database.ReturnIngredient(resolved)
+	if err != nil {
+		return config.Ingredient{}, &inputError{http.StatusNotFound, "Unknown ingredient"}
+	}
+	return ingredient, nil
+}
+
+// resolveProduct looks up a packaged food by barcode instead of name. The
+// amount is given in grams or as a quantity of a mass unit, servings or
+// packs.
+func resolveProduct(input config.TemplateIngredient) (config.Ingredient, *inputError) {
+	if input.Grams <= 0 && input.Quantity <= 0 {
+		return config.Ingredient{}, &inputError{http.StatusBadRequest, "Invalid input"}
+	}
+
+	ingredient, err := database.ReturnProduct(input.Barcode, input)
+	switch {
+	case errors.Is(err, database.ErrInvalidProduct):
+		return config.Ingredient{}, &inputError{http.StatusBadRequest, "Invalid barcode"}
+	case errors.Is(err, database.ErrProductNotFound):
+		return config.Ingredient{}, &inputError{http.StatusNotFound, "Unknown product"}
+	case err != nil:
+		return config.Ingredient{}, &inputError{http.StatusBadRequest, "Invalid quantity: " + err.Error()}
+	}
+	if !database.ValidateGrams(ingredient.Grams) {
+		return config.Ingredient{}, &inputError{http.StatusBadRequest, "Invalid input"}
+	}
+	return ingredient, nil
+}
+
 func AddIngredientHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method == http.MethodOptions {
 		w.WriteHeader(http.StatusOK)
@@ -28,38 +113,34 @@ func AddIngredientHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessionID := config.GetSessionID(w, r)
 
-	var input config.TemplateIngredient
-	err := json.NewDecoder(r.Body).Decode(&input)
-	if err != nil || input.Grams <= 0 || input.Name == "" {
+	var input ingredientInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		http.Error(w, "Invalid input", http.StatusBadRequest)
 		return
 	}
-	input.Name = strings.TrimSpace(input.Name)
-	input.Name = strings.ToLower(input.Name)
 
-	ingredient, err := database.ReturnIngredient(input)
-	if err != nil {
-		http.Error(w, "Unknown ingredient", http.StatusNotFound)
+	ingredient, inputErr := resolveIngredient(input)
+	if inputErr != nil {
+		http.Error(w, inputErr.message, inputErr.status)
 		return
 	}
 
-	config.MU.Lock()
-	defer config.MU.Unlock()
-	for _, ing := range config.UserIngredients[sessionID] {
-		if ing.Name == ingredient.Name {
+	if err := sessionStore.AddIngredient(sessionID, ingredient); err != nil {
+		if errors.Is(err, database.ErrIngredientExists) {
 			http.Error(w, "Ingredient already added", http.StatusConflict)
 			return
 		}
+		http.Error(w, "Failed to add ingredient", http.StatusInternalServerError)
+		return
+	}
+	if display, ok := displayNames(w, r)[strings.ToLower(ingredient.Name)]; ok {
+		ingredient.DisplayName = display
 	}
-	config.UserIngredients[sessionID] = append(config.UserIngredients[sessionID], ingredient)
 
 	w.WriteHeader(http.StatusCreated)
-	err = json.NewEncoder(w).Encode(ingredient)
-	if err != nil {
+	if err := json.NewEncoder(w).Encode(ingredient); err != nil {
 		log.Print(err)
 	}
-
-	//fmt.Printf("SessionID: %s, Ingredients: %+v\n", sessionID, config.UserIngredients[sessionID])
 }
 
 func ListIngredientsHandler(w http.ResponseWriter, r *http.Request) {
@@ -75,13 +156,12 @@ func ListIngredientsHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessionID := config.GetSessionID(w, r)
 
-	config.MU.Lock()
-	defer config.MU.Unlock()
-
-	list := config.UserIngredients[sessionID]
-	sort.Slice(list, func(i, j int) bool {
-		return list[i].Name < list[j].Name
-	})
+	list, err := sessionStore.ListIngredients(sessionID)
+	if err != nil {
+		http.Error(w, "Failed to fetch ingredients", http.StatusInternalServerError)
+		return
+	}
+	database.Localize(list, displayNames(w, r))
 
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(list)
@@ -101,22 +181,32 @@ func CalculateHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessionID := config.GetSessionID(w, r)
 
-	config.MU.Lock()
-	defer config.MU.Unlock()
+	list, err := sessionStore.ListIngredients(sessionID)
+	if err != nil {
+		http.Error(w, "Failed to fetch ingredients", http.StatusInternalServerError)
+		return
+	}
 
-	var total config.Ingredient
+	total := config.SumIngredients(list)
 	total.Name = "Your recipe"
-	for _, ing := range config.UserIngredients[sessionID] {
-		total.Grams += ing.Grams
-		total.Calories += ing.Calories
-		total.Proteins += ing.Proteins
-		total.Carbs += ing.Carbs
-		total.Fats += ing.Fats
-		total.Fiber += ing.Fiber
+
+	currency, ok := requestCurrency(r)
+	if !ok {
+		http.Error(w, "Invalid currency", http.StatusBadRequest)
+		return
+	}
+	perGram, err := database.PricesPerGram(currency)
+	if err != nil {
+		log.Printf("Error loading prices: %v", err)
+		http.Error(w, "Failed to load prices", http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(total)
+	_ = json.NewEncoder(w).Encode(struct {
+		config.Ingredient
+		Cost config.Cost `json:"cost"`
+	}{total, database.CostOf(list, 0, currency, perGram)})
 }
 
 func DeleteIngredientHandler(w http.ResponseWriter, r *http.Request) {
@@ -133,17 +223,10 @@ func DeleteIngredientHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessionID := config.GetSessionID(w, r)
 
-	config.MU.Lock()
-	defer config.MU.Unlock()
-
-	name = strings.ToLower(name)
-	newList := make([]config.Ingredient, 0)
-	for _, ing := range config.UserIngredients[sessionID] {
-		if strings.ToLower(ing.Name) != name {
-			newList = append(newList, ing)
-		}
+	if err := sessionStore.DeleteIngredient(sessionID, strings.ToLower(name)); err != nil {
+		http.Error(w, "Failed to delete ingredient", http.StatusInternalServerError)
+		return
 	}
-	config.UserIngredients[sessionID] = newList
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -166,9 +249,10 @@ func ResetHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessionID := config.GetSessionID(w, r)
 
-	config.MU.Lock()
-	config.UserIngredients[sessionID] = nil
-	config.MU.Unlock()
+	if err := sessionStore.Reset(sessionID); err != nil {
+		http.Error(w, "Failed to reset ingredients", http.StatusInternalServerError)
+		return
+	}
 
 	w.WriteHeader(http.StatusOK)
 	response := map[string]string{"message": "Ingredient list reset."}
@@ -176,3 +260,71 @@ func ResetHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
 	}
 }
+
+func ListNutrientsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	nutrients, err := database.ListNutrients()
+	if err != nil {
+		http.Error(w, "Failed to fetch nutrients", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(nutrients)
+}
+
+func ListUnitsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(units.List())
+}
+
+func ListAllergensHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	allergens, err := database.ListAllergens()
+	if err != nil {
+		http.Error(w, "Failed to fetch allergens", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(allergens)
+}
+
+func IngredientInfoHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		http.Error(w, "Missing ingredient name", http.StatusBadRequest)
+		return
+	}
+
+	info, err := database.GetIngredientInfo(name)
+	if errors.Is(err, database.ErrIngredientNotFound) {
+		http.Error(w, "No metadata for this ingredient", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to fetch ingredient metadata", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(info)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/database"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// requestLocales returns the languages of the Accept-Language header, most
+// preferred first, as lower-case tags. Each regional tag is followed by its
+// base language unless the client ranked that separately.
+func requestLocales(r *http.Request) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	explicit := make(map[string]bool)
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			if v, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, weighted{strings.ReplaceAll(tag, "_", "-"), q})
+		explicit[tags[len(tags)-1].tag] = true
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	var locales []string
+	seen := make(map[string]bool)
+	for _, t := range tags {
+		if !seen[t.tag] {
+			seen[t.tag] = true
+			locales = append(locales, t.tag)
+		}
+		if base, _, ok := strings.Cut(t.tag, "-"); ok && !explicit[base] && !seen[base] {
+			seen[base] = true
+			locales = append(locales, base)
+		}
+	}
+	return locales
+}
+
+// displayNames loads the ingredient names for the request's languages. A
+// failure only costs the translation, so it is logged and not returned.
+func displayNames(w http.ResponseWriter, r *http.Request) map[string]string {
+	w.Header().Add("Vary", "Accept-Language")
+	names, err := database.DisplayNames(requestLocales(r))
+	if err != nil {
+		log.Printf("Error loading display names: %v", err)
+		return nil
+	}
+	return names
+}
@@ -0,0 +1,416 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"FoodStats/internal/nutrition"
+	"FoodStats/internal/planner"
+	"encoding/json"
+	"errors"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const maxPlanRepeats = 7
+
+func planRNG() *rand.Rand {
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+func writeMealPlan(w http.ResponseWriter, status int, plan config.MealPlan) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(plan)
+}
+
+// loadMealPlan reads the plan named in the path and reports any error.
+func loadMealPlan(w http.ResponseWriter, r *http.Request) (config.MealPlan, string, bool) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || id <= 0 {
+		http.Error(w, "Invalid meal plan id", http.StatusBadRequest)
+		return config.MealPlan{}, "", false
+	}
+	sessionID := config.GetSessionID(w, r)
+	plan, err := database.GetMealPlan(sessionID, id)
+	if errors.Is(err, database.ErrMealPlanNotFound) {
+		http.Error(w, "Meal plan not found", http.StatusNotFound)
+		return plan, "", false
+	}
+	if err != nil {
+		log.Printf("Error loading meal plan: %v", err)
+		http.Error(w, "Failed to load meal plan", http.StatusInternalServerError)
+		return plan, "", false
+	}
+	return plan, sessionID, true
+}
+
+func GenerateMealPlanHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input struct {
+		StartDate  string   `json:"start_date"`
+		Days       int      `json:"days"`
+		Meals      []string `json:"meals"`
+		MaxRepeats int      `json:"max_repeats"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			http.Error(w, "Invalid input", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if input.StartDate == "" {
+		input.StartDate = time.Now().Format("2006-01-02")
+	}
+	if !database.ValidateDate(input.StartDate) {
+		http.Error(w, "Invalid start date", http.StatusBadRequest)
+		return
+	}
+	if input.Days == 0 {
+		input.Days = planner.DefaultDays
+	}
+	if input.Days < 1 || input.Days > planner.MaxDays {
+		http.Error(w, "Days must be between 1 and "+strconv.Itoa(planner.MaxDays), http.StatusBadRequest)
+		return
+	}
+	if input.MaxRepeats == 0 {
+		input.MaxRepeats = planner.DefaultMaxRepeats
+	}
+	if input.MaxRepeats < 1 || input.MaxRepeats > maxPlanRepeats {
+		http.Error(w, "max_repeats must be between 1 and "+strconv.Itoa(maxPlanRepeats), http.StatusBadRequest)
+		return
+	}
+	meals, ok := planMeals(input.Meals)
+	if !ok {
+		http.Error(w, "Invalid meal type", http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	profile, exists, err := profiles.Get(sessionID)
+	if err != nil {
+		log.Printf("Error loading profile: %v", err)
+		http.Error(w, "Failed to load profile", http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		http.Error(w, "No profile saved", http.StatusNotFound)
+		return
+	}
+	targets, err := nutrition.ComputeTargets(profile, nutrition.MifflinStJeor)
+	if err != nil {
+		log.Printf("Error computing targets: %v", err)
+		http.Error(w, "Failed to compute targets", http.StatusInternalServerError)
+		return
+	}
+
+	plan := config.MealPlan{
+		StartDate:    input.StartDate,
+		Meals:        meals,
+		MaxRepeats:   input.MaxRepeats,
+		Restrictions: profile.DietaryRestrictions,
+		Allergies:    profile.Allergies,
+		Targets:      targets,
+		Days:         make([]config.PlanDay, input.Days),
+	}
+	start, _ := time.Parse("2006-01-02", plan.StartDate)
+	for i := range plan.Days {
+		plan.Days[i].Date = start.AddDate(0, 0, i).Format("2006-01-02")
+	}
+
+	if !fillMealPlan(w, &plan) {
+		return
+	}
+	if err := database.CreateMealPlan(sessionID, &plan); err != nil {
+		log.Printf("Error saving meal plan: %v", err)
+		http.Error(w, "Failed to save meal plan", http.StatusInternalServerError)
+		return
+	}
+	writeMealPlan(w, http.StatusCreated, plan)
+}
+
+// planMeals checks the requested meals and puts them in the order they are
+// eaten. No meals means all of them.
+func planMeals(requested []string) ([]string, bool) {
+	if len(requested) == 0 {
+		return planner.DefaultMeals, true
+	}
+	wanted := make(map[string]bool, len(requested))
+	for _, meal := range requested {
+		if !database.ValidateMeal(meal) || wanted[meal] {
+			return nil, false
+		}
+		wanted[meal] = true
+	}
+	var meals []string
+	for _, meal := range planner.DefaultMeals {
+		if wanted[meal] {
+			meals = append(meals, meal)
+		}
+	}
+	return meals, true
+}
+
+func fillMealPlan(w http.ResponseWriter, plan *config.MealPlan) bool {
+	cands, err := planner.LoadCandidates(plan.Restrictions, plan.Allergies)
+	if err != nil {
+		log.Printf("Error loading recipes for meal plan: %v", err)
+		http.Error(w, "Failed to generate meal plan", http.StatusInternalServerError)
+		return false
+	}
+	if err := planner.Fill(plan, cands, planRNG()); err != nil {
+		if errors.Is(err, planner.ErrNoCandidates) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return false
+		}
+		log.Printf("Error generating meal plan: %v", err)
+		http.Error(w, "Failed to generate meal plan", http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+func ListMealPlansHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	plans, err := database.ListMealPlans(sessionID)
+	if err != nil {
+		log.Printf("Error loading meal plans: %v", err)
+		http.Error(w, "Failed to load meal plans", http.StatusInternalServerError)
+		return
+	}
+	for i := range plans {
+		planner.Summarize(&plans[i])
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(plans)
+}
+
+func GetMealPlanHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	plan, _, ok := loadMealPlan(w, r)
+	if !ok {
+		return
+	}
+	planner.Summarize(&plan)
+	writeMealPlan(w, http.StatusOK, plan)
+}
+
+func DeleteMealPlanHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || id <= 0 {
+		http.Error(w, "Invalid meal plan id", http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	err = database.DeleteMealPlan(sessionID, id)
+	if errors.Is(err, database.ErrMealPlanNotFound) {
+		http.Error(w, "Meal plan not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Error deleting meal plan: %v", err)
+		http.Error(w, "Failed to delete meal plan", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RegenerateMealPlanHandler plans every meal that is not locked again.
+func RegenerateMealPlanHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	plan, sessionID, ok := loadMealPlan(w, r)
+	if !ok || !fillMealPlan(w, &plan) {
+		return
+	}
+	saveMealPlan(w, sessionID, plan)
+}
+
+// RegenerateMealHandler replaces the recipe of one meal, locked or not.
+func RegenerateMealHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	plan, sessionID, ok := loadMealPlan(w, r)
+	if !ok {
+		return
+	}
+	day, _ := strconv.Atoi(mux.Vars(r)["day"])
+
+	cands, err := planner.LoadCandidates(plan.Restrictions, plan.Allergies)
+	if err != nil {
+		log.Printf("Error loading recipes for meal plan: %v", err)
+		http.Error(w, "Failed to regenerate meal", http.StatusInternalServerError)
+		return
+	}
+	err = planner.Replace(&plan, day, mux.Vars(r)["meal"], cands, planRNG())
+	switch {
+	case errors.Is(err, planner.ErrUnknownMeal):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, planner.ErrNoAlternative):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		log.Printf("Error regenerating meal: %v", err)
+		http.Error(w, "Failed to regenerate meal", http.StatusInternalServerError)
+		return
+	}
+	saveMealPlan(w, sessionID, plan)
+}
+
+// UpdatePlannedMealHandler locks or unlocks a meal, optionally setting its
+// recipe and portion first.
+func UpdatePlannedMealHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input struct {
+		Locked   *bool   `json:"locked"`
+		RecipeID int     `json:"recipe_id"`
+		Portion  float64 `json:"portion"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	if input.Portion < 0 || input.Portion > 10 {
+		http.Error(w, "Invalid portion", http.StatusBadRequest)
+		return
+	}
+
+	plan, sessionID, ok := loadMealPlan(w, r)
+	if !ok {
+		return
+	}
+	day, _ := strconv.Atoi(mux.Vars(r)["day"])
+	var meal *config.PlannedMeal
+	if day >= 1 && day <= len(plan.Days) {
+		for i := range plan.Days[day-1].Meals {
+			if plan.Days[day-1].Meals[i].Meal == mux.Vars(r)["meal"] {
+				meal = &plan.Days[day-1].Meals[i]
+			}
+		}
+	}
+	if meal == nil {
+		http.Error(w, planner.ErrUnknownMeal.Error(), http.StatusNotFound)
+		return
+	}
+
+	if input.RecipeID > 0 {
+		recipe, err := database.GetRecipeByID(input.RecipeID)
+		if err != nil {
+			http.Error(w, "Recipe not found", recipeErrorStatus(err))
+			return
+		}
+		if input.Portion == 0 {
+			input.Portion = 1
+		}
+		meal.RecipeID = recipe.ID
+		meal.RecipeName = recipe.Name
+		meal.Portion = input.Portion
+		meal.Nutrition = config.NutritionalInfo{}
+		if recipe.Nutrition != nil {
+			meal.Nutrition = recipe.Nutrition.PerServing.Scaled(input.Portion)
+		}
+		// A meal chosen by hand stays unless unlocked explicitly.
+		meal.Locked = true
+	} else if input.Portion > 0 {
+		if meal.Portion > 0 {
+			meal.Nutrition = meal.Nutrition.Scaled(input.Portion / meal.Portion)
+		}
+		meal.Portion = input.Portion
+	}
+	if input.Locked != nil {
+		meal.Locked = *input.Locked
+	}
+
+	planner.Summarize(&plan)
+	saveMealPlan(w, sessionID, plan)
+}
+
+func saveMealPlan(w http.ResponseWriter, sessionID string, plan config.MealPlan) {
+	err := database.SaveMealPlanMeals(sessionID, &plan)
+	if errors.Is(err, database.ErrMealPlanNotFound) {
+		http.Error(w, "Meal plan not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Error saving meal plan: %v", err)
+		http.Error(w, "Failed to save meal plan", http.StatusInternalServerError)
+		return
+	}
+	writeMealPlan(w, http.StatusOK, plan)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+var pantryItems *database.PantryRepository
+
+// SetPantryRepository stores pantries in repo and lets shopping lists
+// leave out what they hold.
+func SetPantryRepository(repo *database.PantryRepository) {
+	pantryItems = repo
+	SetPantry(repo)
+}
+
+func ListPantryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	items, err := pantryItems.List(sessionID)
+	if err != nil {
+		log.Printf("Error loading pantry: %v", err)
+		http.Error(w, "Failed to load pantry", http.StatusInternalServerError)
+		return
+	}
+	names := displayNames(w, r)
+	for i := range items {
+		items[i].DisplayName = names[strings.ToLower(items[i].Name)]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func AddPantryItemHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input struct {
+		ingredientInput
+		PurchasedOn string `json:"purchased_on"`
+		BestBefore  string `json:"best_before"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	if (input.PurchasedOn != "" && !database.ValidateDate(input.PurchasedOn)) ||
+		(input.BestBefore != "" && !database.ValidateDate(input.BestBefore)) {
+		http.Error(w, "Invalid date", http.StatusBadRequest)
+		return
+	}
+	if input.PurchasedOn != "" && input.BestBefore != "" && input.BestBefore < input.PurchasedOn {
+		http.Error(w, "Best-before date is before the purchase date", http.StatusBadRequest)
+		return
+	}
+
+	ingredient, inputErr := resolveIngredient(input.ingredientInput)
+	if inputErr != nil {
+		http.Error(w, inputErr.message, inputErr.status)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	item, err := pantryItems.Add(sessionID, config.PantryItem{
+		Name:        ingredient.Name,
+		Grams:       ingredient.Grams,
+		PurchasedOn: input.PurchasedOn,
+		BestBefore:  input.BestBefore,
+	})
+	if err != nil {
+		log.Printf("Error saving pantry item: %v", err)
+		http.Error(w, "Failed to save pantry item", http.StatusInternalServerError)
+		return
+	}
+	item.DisplayName = displayNames(w, r)[strings.ToLower(item.Name)]
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+// ConsumePantryHandler takes an amount of one ingredient out of the pantry.
+func ConsumePantryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input ingredientInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	ingredient, inputErr := resolveIngredient(input)
+	if inputErr != nil {
+		http.Error(w, inputErr.message, inputErr.status)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	consumed, err := pantryItems.Consume(sessionID, map[string]float64{ingredient.Name: ingredient.Grams}, false)
+	if errors.Is(err, database.ErrNotEnoughInPantry) {
+		http.Error(w, "Not enough "+ingredient.Name+" in the pantry", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Printf("Error updating pantry: %v", err)
+		http.Error(w, "Failed to update pantry", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(consumed)
+}
+
+func DeletePantryItemHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || id <= 0 {
+		http.Error(w, "Invalid pantry item id", http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	err = pantryItems.Delete(sessionID, id)
+	if errors.Is(err, database.ErrPantryItemNotFound) {
+		http.Error(w, "Pantry item not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Error deleting pantry item: %v", err)
+		http.Error(w, "Failed to delete pantry item", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// consumeRecipeFromPantry uses up the ingredients of a diary entry that
+// logs a recipe. What the pantry lacks is ignored, and a failure only
+// costs the pantry update, so it is logged.
+func consumeRecipeFromPantry(sessionID string, entry config.DiaryEntry) {
+	if pantryItems == nil || entry.RecipeID == 0 {
+		return
+	}
+	grams := make(map[string]float64, len(entry.Ingredients))
+	for _, ing := range entry.Ingredients {
+		grams[ing.Name] += ing.Grams
+	}
+	if _, err := pantryItems.Consume(sessionID, grams, true); err != nil {
+		log.Printf("Error updating pantry for diary entry %d: %v", entry.ID, err)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	defaultCheapestLimit = 10
+	maxCheapestLimit     = 50
+)
+
+func priceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, database.ErrIngredientNotFound), errors.Is(err, database.ErrPriceNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, database.ErrInvalidPrice):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// requestCurrency reads ?currency=, falling back to the configured one.
+func requestCurrency(r *http.Request) (string, bool) {
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency == "" {
+		return config.GetCurrency(), true
+	}
+	return currency, database.ValidateCurrency(currency)
+}
+
+// priceRecipe prices the recipe in the requested currency when that is not
+// the configured one, which GetRecipe already used.
+func priceRecipe(w http.ResponseWriter, r *http.Request, recipe *config.Recipe) bool {
+	currency, ok := requestCurrency(r)
+	if !ok {
+		http.Error(w, "Invalid currency", http.StatusBadRequest)
+		return false
+	}
+	if recipe.Cost != nil && recipe.Cost.Currency == currency {
+		return true
+	}
+	cost, err := database.RecipeCost(*recipe, currency)
+	if err != nil {
+		log.Printf("Error pricing recipe: %v", err)
+		http.Error(w, "Failed to load prices", http.StatusInternalServerError)
+		return false
+	}
+	recipe.Cost = cost
+	return true
+}
+
+func AddPriceHandler(w http.ResponseWriter, r *http.Request) {
+	var price config.IngredientPrice
+	if err := json.NewDecoder(r.Body).Decode(&price); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	price, err := database.AddPrice(price)
+	if err != nil {
+		http.Error(w, "Failed to add price: "+err.Error(), priceErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(price)
+}
+
+func DeletePriceHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || id <= 0 {
+		http.Error(w, "Invalid price id", http.StatusBadRequest)
+		return
+	}
+	if err := database.DeletePrice(id); err != nil {
+		http.Error(w, "Failed to delete price: "+err.Error(), priceErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": "Price deleted"})
+}
+
+// PriceHistoryHandler lists every price recorded for an ingredient, newest
+// first. ?currency= limits it to one currency.
+func PriceHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency != "" && !database.ValidateCurrency(currency) {
+		http.Error(w, "Invalid currency", http.StatusBadRequest)
+		return
+	}
+
+	prices, err := database.PriceHistory(mux.Vars(r)["name"], currency)
+	if errors.Is(err, database.ErrIngredientNotFound) {
+		http.Error(w, "Unknown ingredient", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Error loading prices: %v", err)
+		http.Error(w, "Failed to load prices", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(prices)
+}
+
+// CheapestRecipesHandler lists the recipes with at least ?protein= grams
+// of protein per serving, cheapest serving first. Only recipes with a
+// price for every ingredient are listed.
+func CheapestRecipesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	protein := 0.0
+	if v := query.Get("protein"); v != "" {
+		var err error
+		if protein, err = strconv.ParseFloat(v, 64); err != nil || protein < 0 || protein > 500 {
+			http.Error(w, "Invalid protein", http.StatusBadRequest)
+			return
+		}
+	}
+	limit := defaultCheapestLimit
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxCheapestLimit {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+	currency, ok := requestCurrency(r)
+	if !ok {
+		http.Error(w, "Invalid currency", http.StatusBadRequest)
+		return
+	}
+
+	perGram, err := database.PricesPerGram(currency)
+	if err != nil {
+		log.Printf("Error loading prices: %v", err)
+		http.Error(w, "Failed to load prices", http.StatusInternalServerError)
+		return
+	}
+	recipes, err := database.ListRecipesWithNutrition()
+	if err != nil {
+		http.Error(w, "Failed to fetch recipes", http.StatusInternalServerError)
+		return
+	}
+
+	cheapest := make([]config.Recipe, 0)
+	for _, recipe := range recipes {
+		if len(recipe.Ingredients) == 0 || recipe.Nutrition.PerServing.Proteins < protein {
+			continue
+		}
+		cost := database.CostOf(recipe.Ingredients, max(recipe.Servings, 1), currency, perGram)
+		if len(cost.Unpriced) > 0 {
+			continue
+		}
+		recipe.Cost = &cost
+		cheapest = append(cheapest, recipe)
+	}
+	sort.SliceStable(cheapest, func(i, j int) bool {
+		a, b := cheapest[i], cheapest[j]
+		if a.Cost.PerServing != b.Cost.PerServing {
+			return a.Cost.PerServing < b.Cost.PerServing
+		}
+		return a.Nutrition.PerServing.Proteins > b.Nutrition.PerServing.Proteins
+	})
+	if len(cheapest) > limit {
+		cheapest = cheapest[:limit]
+	}
+	names := displayNames(w, r)
+	for i := range cheapest {
+		database.Localize(cheapest[i].Ingredients, names)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(cheapest)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/database"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+func GetProductHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	product, err := database.GetProduct(mux.Vars(r)["barcode"])
+	switch {
+	case errors.Is(err, database.ErrInvalidProduct):
+		http.Error(w, "Invalid barcode", http.StatusBadRequest)
+		return
+	case errors.Is(err, database.ErrProductNotFound):
+		http.Error(w, "Unknown product", http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, "Failed to fetch product", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(product)
+}
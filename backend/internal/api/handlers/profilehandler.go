@@ -7,11 +7,19 @@ package handlers
 
 import (
 	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"FoodStats/internal/nutrition"
 	"encoding/json"
+	"errors"
+	"log"
 	"net/http"
 )
 
-var userProfiles = make(map[string]config.UserProfile)
+var profiles *database.ProfileRepository
+
+func SetProfileRepository(repo *database.ProfileRepository) {
+	profiles = repo
+}
 
 func SaveProfileHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method == http.MethodOptions {
@@ -46,8 +54,17 @@ func SaveProfileHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if profile.BodyFat != 0 && (profile.BodyFat < 3 || profile.BodyFat > 70) {
+		http.Error(w, "Invalid body fat percentage", http.StatusBadRequest)
+		return
+	}
+
 	sessionID := config.GetSessionID(w, r)
-	userProfiles[sessionID] = profile
+	if err := profiles.Save(sessionID, profile); err != nil {
+		log.Printf("Error saving profile: %v", err)
+		http.Error(w, "Failed to save profile", http.StatusInternalServerError)
+		return
+	}
 
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]string{"status": "success"})
@@ -65,7 +82,12 @@ func GetProfileHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	sessionID := config.GetSessionID(w, r)
-	profile, exists := userProfiles[sessionID]
+	profile, exists, err := profiles.Get(sessionID)
+	if err != nil {
+		log.Printf("Error loading profile: %v", err)
+		http.Error(w, "Failed to load profile", http.StatusInternalServerError)
+		return
+	}
 
 	if !exists {
 		w.WriteHeader(http.StatusOK)
@@ -90,8 +112,79 @@ func ResetProfileHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessionID := config.GetSessionID(w, r)
 
-	delete(userProfiles, sessionID)
+	if err := profiles.Delete(sessionID); err != nil {
+		log.Printf("Error deleting profile: %v", err)
+		http.Error(w, "Failed to reset profile", http.StatusInternalServerError)
+		return
+	}
 
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]string{"status": "success"})
 }
+
+func ProfileHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	history, err := profiles.History(sessionID)
+	if err != nil {
+		log.Printf("Error loading profile history: %v", err)
+		http.Error(w, "Failed to load profile history", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(history)
+}
+
+func TargetsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	formula, err := nutrition.ParseFormula(r.URL.Query().Get("formula"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	profile, exists, err := profiles.Get(sessionID)
+	if err != nil {
+		log.Printf("Error loading profile: %v", err)
+		http.Error(w, "Failed to load profile", http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		http.Error(w, "No profile saved", http.StatusNotFound)
+		return
+	}
+
+	targets, err := nutrition.ComputeTargets(profile, formula)
+	if errors.Is(err, nutrition.ErrBodyFatRequired) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.Printf("Error computing targets: %v", err)
+		http.Error(w, "Failed to compute targets", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(targets)
+}
@@ -8,35 +8,60 @@ package handlers
 import (
 	"FoodStats/internal/config"
 	"FoodStats/internal/database"
+	"FoodStats/internal/search"
 	"encoding/json"
+	"errors"
+	"html"
+	"log"
+	"math"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
+
+	"github.com/gorilla/mux"
 )
 
+var searchIndex *search.Index
+
+func SetSearchIndex(ix *search.Index) {
+	searchIndex = ix
+}
+
 func SuggestionHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
-	query := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("query")))
+	query := strings.TrimSpace(r.URL.Query().Get("query"))
 	if query == "" {
 		http.Error(w, "Missing query parameter", http.StatusBadRequest)
 		return
 	}
 
-	config.MU.Lock()
-	defer config.MU.Unlock()
-
-	var suggestions []string
-	for _, ing := range database.GetAllIngredients() {
-		if strings.Contains(strings.ToLower(ing.Name), query) {
-			suggestions = append(suggestions, ing.Name)
+	limit := search.DefaultLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > search.MaxLimit {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
 		}
+		limit = n
+	}
+
+	if searchIndex == nil {
+		http.Error(w, "Search index not ready", http.StatusServiceUnavailable)
+		return
 	}
 
-	sort.Strings(suggestions)
+	suggestions := searchIndex.Search(query, limit)
+	names := displayNames(w, r)
+	for i, name := range suggestions {
+		if display, ok := names[strings.ToLower(name)]; ok {
+			suggestions[i] = display
+		}
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(suggestions)
@@ -72,9 +97,13 @@ func GetRecipeHandler(w http.ResponseWriter, r *http.Request) {
 
 	recipe, err := database.GetRecipe(name)
 	if err != nil {
-		http.Error(w, "Recipe not found", http.StatusNotFound)
+		http.Error(w, "Recipe not found", recipeErrorStatus(err))
+		return
+	}
+	if !priceRecipe(w, r, &recipe) {
 		return
 	}
+	database.Localize(recipe.Ingredients, displayNames(w, r))
 
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(recipe)
@@ -101,23 +130,245 @@ func AddRecipeHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var templateIngredients []config.TemplateIngredient
-	for _, ing := range input.Ingredients {
-		templateIngredients = append(templateIngredients, config.TemplateIngredient{
-			Name:  ing.Name,
-			Grams: ing.Grams,
+	input.Ingredients = recipeIngredientsInput(input.Ingredients)
+	id, err := database.AddRecipe(input)
+	if err != nil {
+		http.Error(w, "Failed to add recipe: "+err.Error(), recipeErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"message": "Recipe added", "id": id})
+}
+
+func recipeIngredientsInput(ingredients []config.Ingredient) []config.Ingredient {
+	list := make([]config.Ingredient, 0, len(ingredients))
+	for _, ing := range ingredients {
+		list = append(list, config.Ingredient{
+			TemplateIngredient: config.TemplateIngredient{
+				Name:  strings.TrimSpace(ing.Name),
+				Grams: ing.Grams,
+			},
 		})
 	}
-	err := database.AddRecipe(input.Name, input.Description, templateIngredients)
+	return list
+}
+
+func recipeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, database.ErrRecipeNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, database.ErrRecipeExists), errors.Is(err, database.ErrRecipeInUse):
+		return http.StatusConflict
+	case errors.Is(err, database.ErrInvalidRecipe):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func recipeIDFromPath(r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	return id, err == nil && id > 0
+}
+
+func GetRecipeByIDHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := recipeIDFromPath(r)
+	if !ok {
+		http.Error(w, "Invalid recipe id", http.StatusBadRequest)
+		return
+	}
+
+	recipe, err := database.GetRecipeByID(id)
 	if err != nil {
-		http.Error(w, "Failed to add recipe: "+err.Error(), http.StatusInternalServerError)
+		http.Error(w, "Recipe not found", recipeErrorStatus(err))
 		return
 	}
+	if !priceRecipe(w, r, &recipe) {
+		return
+	}
+	database.Localize(recipe.Ingredients, displayNames(w, r))
 
-	w.WriteHeader(http.StatusCreated)
-	_ = json.NewEncoder(w).Encode(map[string]string{"message": "Recipe added"})
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(recipe)
+}
+
+func UpdateRecipeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := recipeIDFromPath(r)
+	if !ok {
+		http.Error(w, "Invalid recipe id", http.StatusBadRequest)
+		return
+	}
+
+	var input struct {
+		Name        *string             `json:"name"`
+		Description *string             `json:"description"`
+		Servings    *int                `json:"servings"`
+		YieldGrams  *float64            `json:"yield_grams"`
+		Ingredients []config.Ingredient `json:"ingredients"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == http.MethodPut && (input.Name == nil || len(input.Ingredients) == 0) {
+		http.Error(w, "Missing recipe name or ingredients", http.StatusBadRequest)
+		return
+	}
+
+	recipe, err := database.GetRecipeByID(id)
+	if err != nil {
+		http.Error(w, "Recipe not found", recipeErrorStatus(err))
+		return
+	}
+	recipe.Description = html.UnescapeString(recipe.Description)
+	recipe.Ingredients = nil
+
+	if input.Name != nil {
+		recipe.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Description != nil {
+		recipe.Description = *input.Description
+	}
+	if input.Servings != nil {
+		recipe.Servings = *input.Servings
+	}
+	if input.YieldGrams != nil {
+		recipe.YieldGrams = *input.YieldGrams
+	}
+	if input.Ingredients != nil {
+		if len(input.Ingredients) == 0 {
+			http.Error(w, "Recipe needs at least one ingredient", http.StatusBadRequest)
+			return
+		}
+		recipe.Ingredients = recipeIngredientsInput(input.Ingredients)
+	}
+
+	if err := database.UpdateRecipe(id, recipe); err != nil {
+		http.Error(w, "Failed to update recipe: "+err.Error(), recipeErrorStatus(err))
+		return
+	}
+
+	recipe, err = database.GetRecipeByID(id)
+	if err != nil {
+		http.Error(w, "Failed to fetch recipe", recipeErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(recipe)
 }
 
+func ScaleRecipeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := recipeIDFromPath(r)
+	if !ok {
+		http.Error(w, "Invalid recipe id", http.StatusBadRequest)
+		return
+	}
+
+	recipe, err := database.GetRecipeByID(id)
+	if err != nil {
+		http.Error(w, "Recipe not found", recipeErrorStatus(err))
+		return
+	}
+	if !priceRecipe(w, r, &recipe) {
+		return
+	}
+
+	query := r.URL.Query()
+	var factor float64
+	switch {
+	case query.Get("servings") != "":
+		servings, err := strconv.Atoi(query.Get("servings"))
+		if err != nil || !database.ValidateServings(servings) {
+			http.Error(w, "Invalid servings", http.StatusBadRequest)
+			return
+		}
+		factor = float64(servings) / float64(recipe.Servings)
+		recipe.Servings = servings
+	case query.Get("calories") != "":
+		calories, err := strconv.ParseFloat(query.Get("calories"), 64)
+		if err != nil || calories <= 0 || calories > 10000 {
+			http.Error(w, "Invalid calories", http.StatusBadRequest)
+			return
+		}
+		if recipe.Nutrition.PerServing.Calories <= 0 {
+			http.Error(w, "Recipe has no calorie information to scale by", http.StatusUnprocessableEntity)
+			return
+		}
+		factor = calories / recipe.Nutrition.PerServing.Calories
+	default:
+		http.Error(w, "Missing servings or calories", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"factor": factor,
+		"recipe": recipe.Scaled(factor),
+	})
+}
+
+func DeleteRecipeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, ok := recipeIDFromPath(r)
+	if !ok {
+		http.Error(w, "Invalid recipe id", http.StatusBadRequest)
+		return
+	}
+
+	if err := database.DeleteRecipe(id); err != nil {
+		http.Error(w, "Failed to delete recipe: "+err.Error(), recipeErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": "Recipe deleted"})
+}
+
+// SuggestRecipesHandler ranks recipes by how many of their ingredients are
+// in the basket. With ?mode=useitup it ranks them against the pantry
+// instead, putting first the recipes that use up what expires soonest.
 func SuggestRecipesHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -126,12 +377,20 @@ func SuggestRecipesHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessionID := config.GetSessionID(w, r)
 
-	config.MU.Lock()
+	if r.URL.Query().Get("mode") == "useitup" {
+		suggestUseItUp(w, r, sessionID)
+		return
+	}
+
+	basket, err := sessionStore.ListIngredients(sessionID)
+	if err != nil {
+		http.Error(w, "Failed to fetch ingredients", http.StatusInternalServerError)
+		return
+	}
 	userIngs := make(map[string]bool)
-	for _, ing := range config.UserIngredients[sessionID] {
+	for _, ing := range basket {
 		userIngs[strings.ToLower(ing.Name)] = true
 	}
-	config.MU.Unlock()
 
 	recipes, err := database.ListRecipes()
 	if err != nil {
@@ -139,12 +398,7 @@ func SuggestRecipesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	type suggestion struct {
-		config.Recipe
-		Matches int `json:"matches"`
-		Total   int `json:"total"`
-	}
-	var suggestions []suggestion
+	var suggestions []recipeSuggestion
 
 	for _, recipe := range recipes {
 		matchCount := 0
@@ -154,7 +408,7 @@ func SuggestRecipesHandler(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 		if matchCount > 0 {
-			suggestions = append(suggestions, suggestion{
+			suggestions = append(suggestions, recipeSuggestion{
 				Recipe:  recipe,
 				Matches: matchCount,
 				Total:   len(recipe.Ingredients),
@@ -167,3 +421,97 @@ func SuggestRecipesHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(suggestions)
 }
+
+type recipeSuggestion struct {
+	config.Recipe
+	Matches  int      `json:"matches"`
+	Total    int      `json:"total"`
+	Score    float64  `json:"score,omitempty"`
+	Expiring []string `json:"expiring,omitempty"`
+}
+
+const (
+	defaultUseItUpDays = 7
+	maxUseItUpDays     = 60
+)
+
+// suggestUseItUp scores each recipe by the pantry items it uses that reach
+// their best-before date within ?days= days. An item counts more the
+// sooner it expires and the more of it the recipe uses. Items already past
+// their date are not suggested.
+func suggestUseItUp(w http.ResponseWriter, r *http.Request, sessionID string) {
+	days := defaultUseItUpDays
+	if v := r.URL.Query().Get("days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > maxUseItUpDays {
+			http.Error(w, "Invalid number of days", http.StatusBadRequest)
+			return
+		}
+		days = n
+	}
+
+	items, err := pantryItems.List(sessionID)
+	if err != nil {
+		log.Printf("Error loading pantry: %v", err)
+		http.Error(w, "Failed to load pantry", http.StatusInternalServerError)
+		return
+	}
+
+	type expiring struct {
+		grams   float64
+		urgency float64
+	}
+	inPantry := make(map[string]bool)
+	soon := make(map[string]*expiring)
+	for _, item := range items {
+		left := item.ExpiresInDays
+		if left != nil && *left < 0 {
+			continue
+		}
+		name := strings.ToLower(item.Name)
+		inPantry[name] = true
+		if left == nil || *left > days {
+			continue
+		}
+		e, ok := soon[name]
+		if !ok {
+			e = &expiring{}
+			soon[name] = e
+		}
+		e.grams += item.Grams
+		e.urgency = math.Max(e.urgency, 1/float64(1+*left))
+	}
+
+	recipes, err := database.ListRecipes()
+	if err != nil {
+		http.Error(w, "Failed to fetch recipes", http.StatusInternalServerError)
+		return
+	}
+
+	suggestions := make([]recipeSuggestion, 0)
+	for _, recipe := range recipes {
+		s := recipeSuggestion{Recipe: recipe, Total: len(recipe.Ingredients)}
+		for _, ing := range recipe.Ingredients {
+			name := strings.ToLower(ing.Name)
+			if inPantry[name] {
+				s.Matches++
+			}
+			if e, ok := soon[name]; ok {
+				s.Score += e.urgency * math.Min(1, ing.Grams/e.grams)
+				s.Expiring = append(s.Expiring, ing.Name)
+			}
+		}
+		if s.Score > 0 {
+			s.Score = math.Round(s.Score*1000) / 1000
+			suggestions = append(suggestions, s)
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Matches > suggestions[j].Matches
+	})
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(suggestions)
+}
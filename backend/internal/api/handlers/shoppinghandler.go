@@ -0,0 +1,144 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package handlers
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"FoodStats/internal/shopping"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+)
+
+// pantry is nil until a pantry store is configured, and then shopping
+// lists leave out what it holds.
+var pantry shopping.Pantry
+
+func SetPantry(p shopping.Pantry) {
+	pantry = p
+}
+
+// ShoppingListHandler builds a shopping list for recipes given by ID and
+// servings, for a saved meal plan, or for both.
+func ShoppingListHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input struct {
+		Recipes      []shopping.Item `json:"recipes"`
+		PlanID       int             `json:"plan_id"`
+		IgnorePantry bool            `json:"ignore_pantry"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	for _, item := range input.Recipes {
+		if item.RecipeID <= 0 || item.Servings < 0 || item.Servings > 50 {
+			http.Error(w, "Invalid recipe or servings", http.StatusBadRequest)
+			return
+		}
+	}
+
+	sessionID := config.GetSessionID(w, r)
+	items := input.Recipes
+	if input.PlanID > 0 {
+		plan, err := database.GetMealPlan(sessionID, input.PlanID)
+		if errors.Is(err, database.ErrMealPlanNotFound) {
+			http.Error(w, "Meal plan not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("Error loading meal plan: %v", err)
+			http.Error(w, "Failed to load meal plan", http.StatusInternalServerError)
+			return
+		}
+		items = append(items, shopping.PlanItems(plan)...)
+	}
+
+	writeShoppingList(w, r, sessionID, items, !input.IgnorePantry)
+}
+
+// MealPlanShoppingListHandler builds the shopping list for a saved plan.
+// Add ?pantry=false to list everything the plan needs.
+func MealPlanShoppingListHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	plan, sessionID, ok := loadMealPlan(w, r)
+	if !ok {
+		return
+	}
+	writeShoppingList(w, r, sessionID, shopping.PlanItems(plan), r.URL.Query().Get("pantry") != "false")
+}
+
+// writeShoppingList answers with the list as JSON, or with ?format=text or
+// ?format=markdown as a document to print or paste.
+func writeShoppingList(w http.ResponseWriter, r *http.Request, sessionID string, items []shopping.Item, usePantry bool) {
+	format := r.URL.Query().Get("format")
+	switch format {
+	case "", "json", "text", "markdown", "md":
+	default:
+		http.Error(w, "Unknown format", http.StatusBadRequest)
+		return
+	}
+
+	var onHand map[string]float64
+	if usePantry && pantry != nil {
+		var err error
+		if onHand, err = pantry.OnHand(sessionID); err != nil {
+			log.Printf("Error loading pantry: %v", err)
+			http.Error(w, "Failed to load pantry", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	list, err := shopping.Build(items, onHand)
+	switch {
+	case errors.Is(err, shopping.ErrNoRecipes):
+		http.Error(w, "Provide recipes or a meal plan", http.StatusBadRequest)
+		return
+	case errors.Is(err, database.ErrRecipeNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		log.Printf("Error building shopping list: %v", err)
+		http.Error(w, "Failed to build shopping list", http.StatusInternalServerError)
+		return
+	}
+	shopping.Localize(&list, displayNames(w, r))
+
+	switch format {
+	case "text":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		err = shopping.WriteText(w, list)
+	case "markdown", "md":
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		err = shopping.WriteMarkdown(w, list)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(list)
+	}
+	if err != nil {
+		log.Printf("Error writing shopping list: %v", err)
+	}
+}
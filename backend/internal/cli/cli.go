@@ -0,0 +1,88 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package cli
+
+import (
+	"FoodStats/internal/database"
+	"fmt"
+	"log"
+	"os"
+)
+
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"migrate": {
+		usage: "migrate [up|down|status] [-to N] [-steps N] [-dry-run]",
+		run:   runMigrate,
+	},
+	"taxonomy": {
+		usage: "taxonomy import <file.csv> | taxonomy export [file.csv]",
+		run:   runTaxonomy,
+	},
+	"usda": {
+		usage: "usda import [-types foundation,sr_legacy] [-dry-run] <dir>",
+		run:   runUSDA,
+	},
+	"off": {
+		usage: "off import [-dry-run] <export.jsonl|export.csv>",
+		run:   runOFF,
+	},
+}
+
+func IsCommand(name string) bool {
+	_, ok := commands[name]
+	return ok
+}
+
+func Run(args []string) int {
+	if len(args) == 0 {
+		printUsage()
+		return 2
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n", args[0])
+		printUsage()
+		return 2
+	}
+
+	if err := cmd.run(args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "Usage:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %s\n", cmd.usage)
+	}
+}
+
+// openDB opens the database and brings its schema up to date, as the
+// server does when it starts, so commands work on a fresh database.
+func openDB() error {
+	logger := log.New(os.Stderr, "[DB] ", log.LstdFlags)
+	if _, err := database.OpenDB(logger); err != nil {
+		return err
+	}
+
+	applied, err := database.MigrateUp(database.DB, 0, false)
+	if err != nil {
+		database.CloseDB()
+		return fmt.Errorf("database migration failed: %w", err)
+	}
+	for _, mig := range applied {
+		logger.Printf("Applied migration %04d_%s", mig.Version, mig.Name)
+	}
+	return nil
+}
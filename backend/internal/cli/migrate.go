@@ -0,0 +1,73 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package cli
+
+import (
+	"FoodStats/internal/database"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func runMigrate(args []string) error {
+	action := "up"
+	if len(args) > 0 && args[0] != "" && args[0][0] != '-' {
+		action, args = args[0], args[1:]
+	}
+
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	target := fs.Int("to", 0, "migrate up to this version (0 = latest)")
+	steps := fs.Int("steps", 1, "number of migrations to revert")
+	dryRun := fs.Bool("dry-run", false, "run the migrations in a transaction and roll back")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	logger := log.New(os.Stderr, "[DB] ", log.LstdFlags)
+	if _, err := database.OpenDB(logger); err != nil {
+		return err
+	}
+	defer database.CloseDB()
+
+	prefix := ""
+	if *dryRun {
+		prefix = "(dry run) "
+	}
+
+	switch action {
+	case "up":
+		applied, err := database.MigrateUp(database.DB, *target, *dryRun)
+		for _, mig := range applied {
+			fmt.Printf("%sapplied %04d_%s\n", prefix, mig.Version, mig.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("database is up to date")
+		}
+		return err
+	case "down":
+		reverted, err := database.MigrateDown(database.DB, *steps, *dryRun)
+		for _, mig := range reverted {
+			fmt.Printf("%sreverted %04d_%s\n", prefix, mig.Version, mig.Name)
+		}
+		return err
+	case "status":
+		status, err := database.GetMigrationStatus(database.DB)
+		if err != nil {
+			return err
+		}
+		for _, s := range status {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-40s %s\n", s.Version, s.Name, state)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package cli
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"FoodStats/internal/importer"
+	"flag"
+	"fmt"
+)
+
+// offBatchSize is the number of products written per transaction. Full
+// exports hold millions of products, too many to keep in memory at once.
+const offBatchSize = 1000
+
+func runOFF(args []string) error {
+	if len(args) == 0 || args[0] != "import" {
+		return fmt.Errorf("usage: off import [-dry-run] <export.jsonl|export.csv>")
+	}
+
+	fs := flag.NewFlagSet("off import", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "import each batch in a transaction and roll back")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("missing the export file")
+	}
+
+	if err := openDB(); err != nil {
+		return err
+	}
+	defer database.CloseDB()
+
+	defs, err := database.ListNutrients()
+	if err != nil {
+		return err
+	}
+	units := make(map[string]string, len(defs))
+	for _, def := range defs {
+		units[def.Key] = def.Unit
+	}
+
+	var total database.UpsertResult
+	skipped := 0
+	var batch []config.Product
+	flush := func() error {
+		result, err := database.UpsertProducts(batch, *dryRun)
+		if err != nil {
+			return err
+		}
+		total.Created += result.Created
+		total.Updated += result.Updated
+		total.Unchanged += result.Unchanged
+		skipped += len(result.Skipped)
+		for _, s := range result.Skipped {
+			if len(total.Skipped) < maxSkippedShown {
+				total.Skipped = append(total.Skipped, s)
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	stats, err := importer.ReadOpenFoodFacts(fs.Arg(0), importer.OFFOptions{NutrientUnits: units}, func(p config.Product) error {
+		batch = append(batch, p)
+		if len(batch) == offBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil && len(batch) > 0 {
+		err = flush()
+	}
+	if err != nil {
+		return err
+	}
+
+	prefix := ""
+	if *dryRun {
+		prefix = "(dry run) "
+	}
+	fmt.Printf("%sread %d products, %d with nutrition facts: %d created, %d updated, %d unchanged, %d skipped\n",
+		prefix, stats.Rows, stats.Products, total.Created, total.Updated, total.Unchanged, skipped)
+	if stats.Malformed > 0 {
+		fmt.Printf("  %d malformed lines ignored\n", stats.Malformed)
+	}
+	for _, s := range total.Skipped {
+		fmt.Printf("  skipped %s: %s\n", s.Name, s.Reason)
+	}
+	if skipped > len(total.Skipped) {
+		fmt.Printf("  ... and %d more\n", skipped-len(total.Skipped))
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package cli
+
+import (
+	"FoodStats/internal/database"
+	"fmt"
+	"io"
+	"os"
+)
+
+func runTaxonomy(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing action (import or export)")
+	}
+	action, args := args[0], args[1:]
+
+	if err := openDB(); err != nil {
+		return err
+	}
+	defer database.CloseDB()
+
+	switch action {
+	case "import":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: taxonomy import <file.csv>")
+		}
+		in, err := openInput(args[0])
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		n, err := database.ImportTaxonomy(in)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("imported metadata for %d ingredients\n", n)
+		return nil
+	case "export":
+		out := io.Writer(os.Stdout)
+		if len(args) > 0 && args[0] != "-" {
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		return database.ExportTaxonomy(out)
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
+
+// openInput opens path for reading, with "-" meaning standard input.
+func openInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(path)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package cli
+
+import (
+	"FoodStats/internal/database"
+	"FoodStats/internal/importer"
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// maxSkippedShown caps how many skipped foods are listed after an import.
+const maxSkippedShown = 20
+
+func runUSDA(args []string) error {
+	if len(args) == 0 || args[0] != "import" {
+		return fmt.Errorf("usage: usda import [-types foundation,sr_legacy] [-dry-run] <dir>")
+	}
+
+	fs := flag.NewFlagSet("usda import", flag.ContinueOnError)
+	types := fs.String("types", "foundation,sr_legacy", "comma-separated FoodData Central data types to import")
+	dryRun := fs.Bool("dry-run", false, "import in a transaction and roll back")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("missing the directory of the unpacked CSV download")
+	}
+
+	var dataTypes []string
+	for _, t := range strings.Split(*types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			dataTypes = append(dataTypes, strings.TrimSuffix(t, "_food")+"_food")
+		}
+	}
+
+	if err := openDB(); err != nil {
+		return err
+	}
+	defer database.CloseDB()
+
+	defs, err := database.ListNutrients()
+	if err != nil {
+		return err
+	}
+	units := make(map[string]string, len(defs))
+	for _, def := range defs {
+		units[def.Key] = def.Unit
+	}
+
+	records, err := importer.ReadUSDA(fs.Arg(0), importer.USDAOptions{DataTypes: dataTypes, NutrientUnits: units})
+	if err != nil {
+		return err
+	}
+
+	result, err := database.UpsertIngredients(records, database.UpsertOptions{
+		Actor:       "usda-import",
+		SkipInvalid: true,
+		DryRun:      *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	prefix := ""
+	if *dryRun {
+		prefix = "(dry run) "
+	}
+	fmt.Printf("%sread %d foods: %d created, %d updated, %d unchanged, %d skipped\n",
+		prefix, len(records), result.Created, result.Updated, result.Unchanged, len(result.Skipped))
+	for i, skipped := range result.Skipped {
+		if i == maxSkippedShown {
+			fmt.Printf("  ... and %d more\n", len(result.Skipped)-maxSkippedShown)
+			break
+		}
+		fmt.Printf("  skipped %s: %s\n", skipped.Name, skipped.Reason)
+	}
+	return nil
+}
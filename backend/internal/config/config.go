@@ -7,12 +7,11 @@ package config
 
 import (
 	"os"
-	"sync"
+	"strconv"
+	"strings"
+	"time"
 )
 
-var UserIngredients = make(map[string][]Ingredient)
-var MU sync.Mutex
-
 func IsDev() bool {
 	return os.Getenv("GO_ENV") != "production"
 }
@@ -24,3 +23,69 @@ func GetPort() string {
 	}
 	return port
 }
+
+func GetSessionStore() string {
+	return os.Getenv("SESSION_STORE")
+}
+
+// GetAnalyzer selects the nutrition analysis engine: "native" (default)
+// or "python" to run analyzer.py.
+func GetAnalyzer() string {
+	if analyzer := os.Getenv("ANALYZER"); analyzer != "" {
+		return analyzer
+	}
+	return "native"
+}
+
+func GetPythonWorkers() int {
+	if n, err := strconv.Atoi(os.Getenv("PYTHON_WORKERS")); err == nil && n > 0 {
+		return n
+	}
+	return 2
+}
+
+func GetAITimeout() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("AI_TIMEOUT")); err == nil && d > 0 {
+		return d
+	}
+	return 30 * time.Second
+}
+
+func GetAIProvider() string {
+	return os.Getenv("AI_PROVIDER")
+}
+
+// GetAIBaseURL is the OpenAI-compatible endpoint used by the "openai"
+// provider; the default points at a local Ollama server.
+func GetAIBaseURL() string {
+	if url := os.Getenv("AI_BASE_URL"); url != "" {
+		return url
+	}
+	return "http://localhost:11434/v1"
+}
+
+func GetAIModel() string {
+	if model := os.Getenv("AI_MODEL"); model != "" {
+		return model
+	}
+	return "llama3.2"
+}
+
+func GetAIAPIKey() string {
+	return os.Getenv("AI_API_KEY")
+}
+
+// GetCurrency is the currency recipe costs are given in when a request
+// does not name one.
+func GetCurrency() string {
+	if currency := os.Getenv("CURRENCY"); currency != "" {
+		return strings.ToUpper(currency)
+	}
+	return "EUR"
+}
+
+// GetAdminToken returns the bearer token for the admin API. The admin API
+// is disabled while it is empty.
+func GetAdminToken() string {
+	return os.Getenv("ADMIN_TOKEN")
+}
@@ -5,17 +5,179 @@
 
 package config
 
+import (
+	"math"
+	"slices"
+	"time"
+)
+
 type TemplateIngredient struct {
-	Name  string  `json:"name"`
-	Grams float64 `json:"grams"`
+	Name        string  `json:"name"`
+	DisplayName string  `json:"display_name,omitempty"`
+	Grams       float64 `json:"grams"`
+	Quantity    float64 `json:"quantity,omitempty"`
+	Unit        string  `json:"unit,omitempty"`
+	Barcode     string  `json:"barcode,omitempty"`
+}
+
+type Nutrients map[string]float64
+
+func (n Nutrients) Scaled(factor float64) Nutrients {
+	if len(n) == 0 {
+		return nil
+	}
+	scaled := make(Nutrients, len(n))
+	for key, amount := range n {
+		scaled[key] = amount * factor
+	}
+	return scaled
+}
+
+func (n *Nutrients) Add(other Nutrients) {
+	if len(other) == 0 {
+		return
+	}
+	if *n == nil {
+		*n = make(Nutrients, len(other))
+	}
+	for key, amount := range other {
+		(*n)[key] += amount
+	}
 }
 
 type NutritionalInfo struct {
-	Calories float64 `json:"calories"`
-	Proteins float64 `json:"proteins"`
-	Carbs    float64 `json:"carbs"`
-	Fats     float64 `json:"fats"`
-	Fiber    float64 `json:"fiber"`
+	Calories  float64   `json:"calories"`
+	Proteins  float64   `json:"proteins"`
+	Carbs     float64   `json:"carbs"`
+	Fats      float64   `json:"fats"`
+	Fiber     float64   `json:"fiber"`
+	Nutrients Nutrients `json:"nutrients,omitempty"`
+	// MissingNutrients names the ingredients without extended nutrient
+	// data, which Nutrients therefore leaves out.
+	MissingNutrients []string `json:"missing_nutrients,omitempty"`
+}
+
+func (n NutritionalInfo) Scaled(factor float64) NutritionalInfo {
+	return NutritionalInfo{
+		Calories:         n.Calories * factor,
+		Proteins:         n.Proteins * factor,
+		Carbs:            n.Carbs * factor,
+		Fats:             n.Fats * factor,
+		Fiber:            n.Fiber * factor,
+		Nutrients:        n.Nutrients.Scaled(factor),
+		MissingNutrients: n.MissingNutrients,
+	}
+}
+
+func (n *NutritionalInfo) Add(other NutritionalInfo) {
+	n.Calories += other.Calories
+	n.Proteins += other.Proteins
+	n.Carbs += other.Carbs
+	n.Fats += other.Fats
+	n.Fiber += other.Fiber
+	n.Nutrients.Add(other.Nutrients)
+	for _, name := range other.MissingNutrients {
+		if !slices.Contains(n.MissingNutrients, name) {
+			n.MissingNutrients = append(n.MissingNutrients, name)
+		}
+	}
+}
+
+type NutrientDefinition struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+}
+
+// Allergen is one of the major allergen groups. EU14 and US9 mark the
+// groups that must be declared under EU and US labelling rules.
+type Allergen struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	EU14 bool   `json:"eu14"`
+	US9  bool   `json:"us9"`
+}
+
+// IngredientRecord is a catalogue row: the per-100g values of an
+// ingredient and, if known, its density in g/ml. Source says where the
+// values come from; FDCID is the USDA FoodData Central ID of imported rows.
+type IngredientRecord struct {
+	Name string `json:"name"`
+	NutritionalInfo
+	Density float64 `json:"density,omitempty"`
+	Source  string  `json:"source,omitempty"`
+	FDCID   int     `json:"fdc_id,omitempty"`
+}
+
+// Product is a packaged food identified by its barcode, with values per
+// 100 g. ServingSize is the label text, such as "2 biscuits (25 g)".
+type Product struct {
+	Barcode      string  `json:"barcode"`
+	Name         string  `json:"name"`
+	Brand        string  `json:"brand,omitempty"`
+	ServingSize  string  `json:"serving_size,omitempty"`
+	ServingGrams float64 `json:"serving_grams,omitempty"`
+	PackageGrams float64 `json:"package_grams,omitempty"`
+	NutritionalInfo
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IngredientPrice is what an ingredient cost at a store on a date, either
+// per kilogram or per pack of PackGrams. Currency is an ISO 4217 code.
+type IngredientPrice struct {
+	ID         int       `json:"id"`
+	Ingredient string    `json:"ingredient"`
+	Price      float64   `json:"price"`
+	Unit       string    `json:"unit"`
+	PackGrams  float64   `json:"pack_grams,omitempty"`
+	Currency   string    `json:"currency"`
+	Store      string    `json:"store,omitempty"`
+	PricedOn   string    `json:"priced_on"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Cost is what an amount of food costs at the latest known prices.
+// Ingredients without a price in Currency are listed in Unpriced and left
+// out of the totals.
+type Cost struct {
+	Currency   string   `json:"currency"`
+	Total      float64  `json:"total"`
+	PerServing float64  `json:"per_serving,omitempty"`
+	Unpriced   []string `json:"unpriced,omitempty"`
+}
+
+// IngredientAuditEntry records one change to the catalogue. Before is nil
+// for a create and After is nil for a delete.
+type IngredientAuditEntry struct {
+	ID         int               `json:"id"`
+	Ingredient string            `json:"ingredient"`
+	Action     string            `json:"action"`
+	Actor      string            `json:"actor"`
+	Before     *IngredientRecord `json:"before,omitempty"`
+	After      *IngredientRecord `json:"after,omitempty"`
+	ChangedAt  time.Time         `json:"changed_at"`
+}
+
+// IngredientAlias is another name for a catalogue ingredient. Locale is a
+// lower-case BCP 47 tag, or empty for names used in every language. The
+// preferred alias of a locale is the ingredient's display name there.
+type IngredientAlias struct {
+	Alias      string `json:"alias"`
+	Locale     string `json:"locale"`
+	Ingredient string `json:"ingredient"`
+	Preferred  bool   `json:"preferred"`
+}
+
+// IngredientInfo is the taxonomy metadata kept for an ingredient.
+type IngredientInfo struct {
+	Name            string   `json:"name"`
+	FoodGroup       string   `json:"food_group"`
+	AnimalDerived   bool     `json:"animal_derived"`
+	ContainsMeat    bool     `json:"contains_meat"`
+	ContainsGluten  bool     `json:"contains_gluten"`
+	ContainsLactose bool     `json:"contains_lactose"`
+	Allergens       []string `json:"allergens"`
 }
 
 type Ingredient struct {
@@ -23,19 +185,105 @@ type Ingredient struct {
 	NutritionalInfo
 }
 
+func SumIngredients(ingredients []Ingredient) Ingredient {
+	var total Ingredient
+	for _, ing := range ingredients {
+		total.Grams += ing.Grams
+		total.NutritionalInfo.Add(ing.NutritionalInfo)
+	}
+	return total
+}
+
+func (i Ingredient) Scaled(factor float64) Ingredient {
+	i.Grams *= factor
+	i.Quantity *= factor
+	i.NutritionalInfo = i.NutritionalInfo.Scaled(factor)
+	return i
+}
+
+type RecipeNutrition struct {
+	Total      NutritionalInfo `json:"total"`
+	PerServing NutritionalInfo `json:"per_serving"`
+	Per100g    NutritionalInfo `json:"per_100g"`
+}
+
 type Recipe struct {
-	ID          int          `json:"id,omitempty"`
-	Name        string       `json:"name"`
-	Description string       `json:"description,omitempty"`
-	Ingredients []Ingredient `json:"ingredients,omitempty"`
-	Similarity  float64      `json:"similarity,omitempty"`
-	Vegan       bool         `json:"vegan,omitempty"`
+	ID                 int              `json:"id,omitempty"`
+	Name               string           `json:"name"`
+	Description        string           `json:"description,omitempty"`
+	Ingredients        []Ingredient     `json:"ingredients,omitempty"`
+	Similarity         float64          `json:"similarity,omitempty"`
+	MissingIngredients []string         `json:"missing_ingredients,omitempty"`
+	Vegan              bool             `json:"vegan,omitempty"`
+	Vegetarian         bool             `json:"vegetarian,omitempty"`
+	Allergens          []string         `json:"allergens,omitempty"`
+	Servings           int              `json:"servings,omitempty"`
+	YieldGrams         float64          `json:"yield_grams,omitempty"`
+	Nutrition          *RecipeNutrition `json:"nutrition,omitempty"`
+	Cost               *Cost            `json:"cost,omitempty"`
+}
+
+// ComputeNutrition fills in the recipe totals. Per-100g values use the
+// cooked yield when known and the raw ingredient weight otherwise.
+func (r *Recipe) ComputeNutrition() {
+	total := SumIngredients(r.Ingredients)
+
+	servings := r.Servings
+	if servings < 1 {
+		servings = 1
+	}
+	weight := r.YieldGrams
+	if weight <= 0 {
+		weight = total.Grams
+	}
+
+	nutrition := &RecipeNutrition{
+		Total:      total.NutritionalInfo,
+		PerServing: total.NutritionalInfo.Scaled(1 / float64(servings)),
+	}
+	if weight > 0 {
+		nutrition.Per100g = total.NutritionalInfo.Scaled(100 / weight)
+	}
+	r.Nutrition = nutrition
+}
+
+func (r Recipe) Scaled(factor float64) Recipe {
+	ingredients := make([]Ingredient, len(r.Ingredients))
+	for i, ing := range r.Ingredients {
+		ingredients[i] = ing.Scaled(factor)
+	}
+	r.Ingredients = ingredients
+	r.YieldGrams *= factor
+	r.ComputeNutrition()
+	if r.Cost != nil {
+		cost := *r.Cost
+		cost.Total = math.Round(cost.Total*factor*100) / 100
+		cost.PerServing = math.Round(cost.Total/float64(max(r.Servings, 1))*100) / 100
+		r.Cost = &cost
+	}
+	return r
+}
+
+type NutritionTargets struct {
+	Formula  string             `json:"formula"`
+	BMR      float64            `json:"bmr"`
+	TDEE     float64            `json:"tdee"`
+	Calories float64            `json:"calories"`
+	Proteins float64            `json:"proteins"`
+	Carbs    float64            `json:"carbs"`
+	Fats     float64            `json:"fats"`
+	Fiber    float64            `json:"fiber"`
+	Limits   map[string]float64 `json:"limits"`
 }
 
 type NutritionAnalysis struct {
-	HealthScore     float64            `json:"health_score"`
-	Recommendations []string           `json:"recommendations"`
-	NutrientBalance map[string]float64 `json:"nutrient_balance"`
+	HealthScore      float64                `json:"health_score"`
+	Recommendations  []string               `json:"recommendations"`
+	NutrientBalance  map[string]float64     `json:"nutrient_balance"`
+	NutrientScores   map[string]float64     `json:"nutrient_scores"`
+	MetricsBreakdown map[string]float64     `json:"metrics_breakdown"`
+	UserData         map[string]interface{} `json:"user_data"`
+	Targets          *NutritionTargets      `json:"targets,omitempty"`
 }
 
 type UserProfile struct {
@@ -46,4 +294,103 @@ type UserProfile struct {
 	ActivityLevel       string   `json:"activityLevel"`
 	Goal                string   `json:"goal"`
 	DietaryRestrictions []string `json:"dietary_restrictions"`
+	Allergies           []string `json:"allergies,omitempty"`
+	BodyFat             float64  `json:"body_fat,omitempty"`
+}
+
+var MealTypes = []string{"breakfast", "lunch", "dinner", "snack"}
+
+type DiaryEntry struct {
+	ID          int             `json:"id"`
+	Date        string          `json:"date"`
+	Meal        string          `json:"meal"`
+	RecipeID    int             `json:"recipe_id,omitempty"`
+	RecipeName  string          `json:"recipe_name,omitempty"`
+	Portion     float64         `json:"portion,omitempty"`
+	Ingredients []Ingredient    `json:"ingredients"`
+	Nutrition   NutritionalInfo `json:"nutrition"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// MealPlan assigns a recipe portion to each meal of consecutive days. The
+// restrictions, allergies and targets are those it was generated for, so
+// regenerating a meal keeps to them even after the profile changes.
+type MealPlan struct {
+	ID           int              `json:"id"`
+	StartDate    string           `json:"start_date"`
+	Meals        []string         `json:"meals"`
+	MaxRepeats   int              `json:"max_repeats"`
+	Restrictions []string         `json:"dietary_restrictions"`
+	Allergies    []string         `json:"allergies"`
+	Targets      NutritionTargets `json:"targets"`
+	Days         []PlanDay        `json:"days"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// PlanDay is one day of a meal plan. Day counts from 1.
+type PlanDay struct {
+	Day           int             `json:"day"`
+	Date          string          `json:"date"`
+	Meals         []PlannedMeal   `json:"meals"`
+	Total         NutritionalInfo `json:"total"`
+	WithinTargets bool            `json:"within_targets"`
+}
+
+// PlannedMeal is a portion, in servings, of a recipe. Locked meals are kept
+// when the plan is regenerated.
+type PlannedMeal struct {
+	Meal       string          `json:"meal"`
+	RecipeID   int             `json:"recipe_id"`
+	RecipeName string          `json:"recipe_name"`
+	Portion    float64         `json:"portion"`
+	Locked     bool            `json:"locked"`
+	Nutrition  NutritionalInfo `json:"nutrition"`
+}
+
+// ShoppingList is what to buy for a set of recipes, grouped by food
+// group. Ingredients the pantry already covers are listed apart.
+type ShoppingList struct {
+	Categories []ShoppingCategory `json:"categories"`
+	InPantry   []ShoppingItem     `json:"in_pantry,omitempty"`
+}
+
+type ShoppingCategory struct {
+	FoodGroup string         `json:"food_group"`
+	Name      string         `json:"name"`
+	Items     []ShoppingItem `json:"items"`
+}
+
+// ShoppingItem is one ingredient to buy. Grams is what the recipes need
+// beyond the pantry; Quantity and Unit round it up to what a shop sells.
+type ShoppingItem struct {
+	Name        string   `json:"name"`
+	DisplayName string   `json:"display_name,omitempty"`
+	NeededGrams float64  `json:"needed_grams"`
+	PantryGrams float64  `json:"pantry_grams,omitempty"`
+	Grams       float64  `json:"grams"`
+	Quantity    float64  `json:"quantity"`
+	Unit        string   `json:"unit"`
+	Recipes     []string `json:"recipes"`
+}
+
+// PantryItem is one purchase of an ingredient kept at home. Dates are
+// YYYY-MM-DD and optional; ExpiresInDays counts from today to BestBefore
+// and is negative once it has passed.
+type PantryItem struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	DisplayName   string    `json:"display_name,omitempty"`
+	Grams         float64   `json:"grams"`
+	PurchasedOn   string    `json:"purchased_on,omitempty"`
+	BestBefore    string    `json:"best_before,omitempty"`
+	ExpiresInDays *int      `json:"expires_in_days,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type DaySummary struct {
+	Date    string                     `json:"date"`
+	Entries int                        `json:"entries"`
+	Meals   map[string]NutritionalInfo `json:"meals"`
+	Total   Ingredient                 `json:"total"`
 }
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+const AppName = "FoodStats"
+
+type FileConfig struct {
+	DatabasePath string `json:"database_path"`
+}
+
+var dbPathOverride string
+
+func SetDBPath(path string) {
+	dbPathOverride = path
+}
+
+func ConfigFilePath() string {
+	if path := os.Getenv("FOODSTATS_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, AppName, "config.json")
+}
+
+func LoadFileConfig() (FileConfig, error) {
+	var cfg FileConfig
+
+	path := ConfigFilePath()
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, err
+	}
+	if cfg.DatabasePath != "" && !filepath.IsAbs(cfg.DatabasePath) {
+		cfg.DatabasePath = filepath.Join(filepath.Dir(path), cfg.DatabasePath)
+	}
+	return cfg, nil
+}
+
+func UserDataDir() (string, error) {
+	switch runtime.GOOS {
+	case "windows", "darwin":
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(dir, AppName), nil
+	default:
+		if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+			return filepath.Join(dir, AppName), nil
+		}
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(home, ".local", "share", AppName), nil
+	}
+}
+
+// GetDBPath resolves the database location: the -db flag, then the
+// FOODSTATS_DB environment variable, then the config file, and finally
+// nutrition_data.db inside the user data directory.
+func GetDBPath() (string, error) {
+	if dbPathOverride != "" {
+		return dbPathOverride, nil
+	}
+
+	if path := os.Getenv("FOODSTATS_DB"); path != "" {
+		return path, nil
+	}
+
+	cfg, err := LoadFileConfig()
+	if err != nil {
+		return "", err
+	}
+	if cfg.DatabasePath != "" {
+		return cfg.DatabasePath, nil
+	}
+
+	return DefaultDBPath()
+}
+
+// DefaultDBPath is nutrition_data.db inside the user data directory.
+func DefaultDBPath() (string, error) {
+	dir, err := UserDataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "nutrition_data.db"), nil
+}
+
+// LegacyDBPath is where releases before the user data directory kept the
+// database: database/nutrition_data.db next to the backend executable.
+func LegacyDBPath() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(exe), "database", "nutrition_data.db"), nil
+}
@@ -6,6 +6,7 @@
 package config
 
 import (
+	"log"
 	"net/http"
 	"sync"
 	"time"
@@ -20,23 +21,35 @@ type Session struct {
 	Data      map[string]interface{}
 }
 
+type SessionExpirer interface {
+	ExpireSessions(maxAge time.Duration) error
+}
+
+const SessionTTL = 24 * time.Hour
+
 var (
 	sessions = make(map[string]*Session)
 	mu       sync.RWMutex
 )
 
-func CleanupSessions() {
+func CleanupSessions(expirers ...SessionExpirer) {
 	ticker := time.NewTicker(30 * time.Minute)
 	go func() {
 		for range ticker.C {
 			mu.Lock()
 			now := time.Now()
 			for id, session := range sessions {
-				if now.Sub(session.LastSeen) > 24*time.Hour {
+				if now.Sub(session.LastSeen) > SessionTTL {
 					delete(sessions, id)
 				}
 			}
 			mu.Unlock()
+
+			for _, expirer := range expirers {
+				if err := expirer.ExpireSessions(SessionTTL); err != nil {
+					log.Printf("Session cleanup failed: %v", err)
+				}
+			}
 		}
 	}()
 }
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// aliasKey is the lookup form of an alias. SQLite only folds ASCII case,
+// so keys are lower-cased here rather than with COLLATE NOCASE.
+func aliasKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// ResolveIngredientName returns the catalogue name for name, which may be
+// the catalogue name in any case or an alias in any language.
+func ResolveIngredientName(name string) (string, error) {
+	var canonical string
+	err := DB.QueryRow("SELECT NAME FROM ingredients WHERE LOWER(NAME) = LOWER(?)", strings.TrimSpace(name)).Scan(&canonical)
+	if err == nil {
+		return canonical, nil
+	}
+	if err != sql.ErrNoRows {
+		return "", err
+	}
+
+	err = DB.QueryRow(`
+        SELECT i.NAME FROM ingredient_aliases a
+        JOIN ingredients i ON LOWER(i.NAME) = LOWER(a.ingredient_name)
+        WHERE a.alias_key = ?
+        ORDER BY a.locale = '' DESC, a.preferred DESC
+        LIMIT 1`, aliasKey(name)).Scan(&canonical)
+	if err == sql.ErrNoRows {
+		return "", ErrIngredientNotFound
+	}
+	return canonical, err
+}
+
+func ListAliases() ([]config.IngredientAlias, error) {
+	rows, err := DB.Query("SELECT alias, locale, ingredient_name, preferred FROM ingredient_aliases ORDER BY ingredient_name, locale, alias")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []config.IngredientAlias
+	for rows.Next() {
+		var a config.IngredientAlias
+		if err := rows.Scan(&a.Alias, &a.Locale, &a.Ingredient, &a.Preferred); err != nil {
+			return nil, fmt.Errorf("scanning alias failed: %w", err)
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+// DisplayNames returns the preferred name of each ingredient in the first
+// of locales that has one, keyed by lower-cased catalogue name.
+// Ingredients without a translation are left out.
+func DisplayNames(locales []string) (map[string]string, error) {
+	names := make(map[string]string)
+	if len(locales) == 0 {
+		return names, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(locales)), ",")
+	args := make([]interface{}, len(locales))
+	rank := make(map[string]int, len(locales))
+	for i, locale := range locales {
+		args[i] = locale
+		if _, ok := rank[locale]; !ok {
+			rank[locale] = i
+		}
+	}
+
+	rows, err := DB.Query("SELECT alias, locale, ingredient_name FROM ingredient_aliases WHERE preferred = 1 AND locale IN ("+placeholders+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	best := make(map[string]int)
+	for rows.Next() {
+		var alias, locale, ingredient string
+		if err := rows.Scan(&alias, &locale, &ingredient); err != nil {
+			return nil, fmt.Errorf("scanning alias failed: %w", err)
+		}
+		key := strings.ToLower(ingredient)
+		if r, ok := best[key]; ok && r <= rank[locale] {
+			continue
+		}
+		best[key] = rank[locale]
+		names[key] = alias
+	}
+	return names, rows.Err()
+}
+
+// Localize sets DisplayName on each ingredient with a translation in names.
+func Localize(ingredients []config.Ingredient, names map[string]string) {
+	for i := range ingredients {
+		if display, ok := names[strings.ToLower(ingredients[i].Name)]; ok {
+			ingredients[i].DisplayName = display
+		}
+	}
+}
+
+// canonicalizeIngredients returns a copy of a recipe's ingredients with
+// plurals and aliases replaced by catalogue names. Names that are not in
+// the catalogue make the recipe invalid, and a nil slice stays nil.
+func canonicalizeIngredients(ingredients []config.Ingredient) ([]config.Ingredient, error) {
+	if ingredients == nil {
+		return nil, nil
+	}
+	out := make([]config.Ingredient, len(ingredients))
+	copy(out, ingredients)
+	var unknown []string
+	for i := range out {
+		canonical, _, err := findIngredient(strings.TrimSpace(out[i].Name))
+		if err == ErrIngredientNotFound {
+			unknown = append(unknown, out[i].Name)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(canonical, out[i].Name) {
+			out[i].Name = strings.ToLower(canonical)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%w: unknown ingredients: %s", ErrInvalidRecipe, strings.Join(unknown, ", "))
+	}
+	return out, nil
+}
@@ -0,0 +1,558 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidIngredient = errors.New("invalid ingredient")
+	ErrCatalogDuplicate  = errors.New("ingredient already exists")
+	ErrIngredientInUse   = errors.New("ingredient is still in use")
+)
+
+// Energy check tolerance. Calories may fall below the Atwater estimate
+// with fiber counted at 0 kcal/g, or above it with fiber at 2 kcal/g, by
+// this share plus a fixed margin for rounding in the source data.
+const (
+	energyTolerance = 0.25
+	energyMargin    = 20
+	// macroRoundingMargin allows protein, carbs and fat to add up to
+	// slightly more than 100g because source values are rounded.
+	macroRoundingMargin = 1
+	// maxCalories is pure fat at the 9.02 kcal/g factor USDA uses for
+	// some oils, rounded up.
+	maxCalories = 910
+	maxDensity  = 5
+)
+
+// IngredientInUseError says what still references an ingredient: recipes
+// by name, diary entries and pantry lots by count. It wraps
+// ErrIngredientInUse.
+type IngredientInUseError struct {
+	Recipes      []string
+	DiaryEntries int
+	PantryLots   int
+}
+
+func (e *IngredientInUseError) Error() string {
+	var uses []string
+	if len(e.Recipes) > 0 {
+		uses = append(uses, "recipes "+strings.Join(e.Recipes, ", "))
+	}
+	if e.DiaryEntries > 0 {
+		uses = append(uses, fmt.Sprintf("%d diary entries", e.DiaryEntries))
+	}
+	if e.PantryLots > 0 {
+		uses = append(uses, fmt.Sprintf("%d pantry lots", e.PantryLots))
+	}
+	return fmt.Sprintf("%s: %s", ErrIngredientInUse, strings.Join(uses, "; "))
+}
+
+func (e *IngredientInUseError) Unwrap() error {
+	return ErrIngredientInUse
+}
+
+// ValidateIngredientRecord checks that the per-100g values are possible.
+// The energy check compares calories with the Atwater factors and can be
+// skipped for foods they do not fit, such as sugar alcohols.
+func ValidateIngredientRecord(rec config.IngredientRecord, checkEnergy bool) error {
+	if !ValidateCatalogName(rec.Name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidIngredient, rec.Name)
+	}
+
+	if rec.Density < 0 || rec.Density > maxDensity {
+		return fmt.Errorf("%w: density must be between 0 and %d g/ml", ErrInvalidIngredient, maxDensity)
+	}
+	if err := validateMacros(rec.NutritionalInfo, checkEnergy); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidIngredient, err)
+	}
+	return nil
+}
+
+// validateMacros checks per-100g calories and macros, and with
+// checkEnergy that the calories roughly match the Atwater factors.
+func validateMacros(info config.NutritionalInfo, checkEnergy bool) error {
+	macros := []struct {
+		name  string
+		value float64
+	}{
+		{"proteins", info.Proteins},
+		{"carbs", info.Carbs},
+		{"fats", info.Fats},
+		{"fiber", info.Fiber},
+	}
+	for _, m := range macros {
+		if math.IsNaN(m.value) || m.value < 0 || m.value > 100 {
+			return fmt.Errorf("%s must be between 0 and 100 g per 100 g", m.name)
+		}
+	}
+	if sum := info.Proteins + info.Carbs + info.Fats; sum > 100+macroRoundingMargin {
+		return fmt.Errorf("proteins, carbs and fats add up to %.1f g per 100 g", sum)
+	}
+	if math.IsNaN(info.Calories) || info.Calories < 0 || info.Calories > maxCalories {
+		return fmt.Errorf("calories must be between 0 and %d per 100 g", maxCalories)
+	}
+	if checkEnergy {
+		low := 4*info.Proteins + 4*math.Max(info.Carbs-info.Fiber, 0) + 9*info.Fats
+		high := 4*info.Proteins + 4*info.Carbs + 9*info.Fats + 2*info.Fiber
+		if info.Calories < low*(1-energyTolerance)-energyMargin || info.Calories > high*(1+energyTolerance)+energyMargin {
+			return fmt.Errorf("%.0f kcal does not match the macros (expected %.0f-%.0f kcal)",
+				info.Calories, low, high)
+		}
+	}
+	return nil
+}
+
+// validateNutrients checks micronutrient keys against the nutrients table
+// and caps gram-based amounts at 100g per 100g. Bad values are reported as
+// invalid, the sentinel of the record being checked.
+func validateNutrients(q querier, nutrients config.Nutrients, invalid error) error {
+	if len(nutrients) == 0 {
+		return nil
+	}
+	units := make(map[string]string)
+	rows, err := q.Query("SELECT key, unit FROM nutrients")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key, unit string
+		if err := rows.Scan(&key, &unit); err != nil {
+			return err
+		}
+		units[key] = unit
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for key, amount := range nutrients {
+		unit, ok := units[key]
+		if !ok {
+			return fmt.Errorf("%w: unknown nutrient %q", invalid, key)
+		}
+		if math.IsNaN(amount) || amount < 0 || (unit == "g" && amount > 100) {
+			return fmt.Errorf("%w: invalid amount for %s", invalid, key)
+		}
+	}
+	return nil
+}
+
+// querier is the part of *sql.DB and *sql.Tx the catalogue code needs.
+type querier interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+func GetIngredientRecord(name string) (config.IngredientRecord, error) {
+	return getIngredientRecord(DB, name)
+}
+
+func getIngredientRecord(q querier, name string) (config.IngredientRecord, error) {
+	var rec config.IngredientRecord
+	var calories, proteins, carbs, fats, fiber, density sql.NullFloat64
+	var source sql.NullString
+	var fdcID sql.NullInt64
+	err := q.QueryRow("SELECT NAME, CALORIES, PROTEINS, CARBS, FATS, FIBER, DENSITY, SOURCE, FDC_ID FROM ingredients WHERE LOWER(NAME) = LOWER(?)",
+		strings.TrimSpace(name)).Scan(&rec.Name, &calories, &proteins, &carbs, &fats, &fiber, &density, &source, &fdcID)
+	if err == sql.ErrNoRows {
+		return rec, ErrIngredientNotFound
+	}
+	if err != nil {
+		return rec, err
+	}
+	rec.Calories, rec.Proteins, rec.Carbs = calories.Float64, proteins.Float64, carbs.Float64
+	rec.Fats, rec.Fiber, rec.Density = fats.Float64, fiber.Float64, density.Float64
+	rec.Source, rec.FDCID = source.String, int(fdcID.Int64)
+
+	rows, err := q.Query("SELECT nutrient_key, amount FROM ingredient_nutrients WHERE ingredient_name = ?", rec.Name)
+	if err != nil {
+		return rec, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		var amount float64
+		if err := rows.Scan(&key, &amount); err != nil {
+			return rec, err
+		}
+		if rec.Nutrients == nil {
+			rec.Nutrients = make(config.Nutrients)
+		}
+		rec.Nutrients[key] = amount
+	}
+	return rec, rows.Err()
+}
+
+// CreateIngredient adds a new catalogue row.
+func CreateIngredient(rec config.IngredientRecord, actor string, checkEnergy bool) error {
+	return withCatalogTx(false, func(tx *sql.Tx) error {
+		if _, err := getIngredientRecord(tx, rec.Name); err == nil {
+			return fmt.Errorf("%w: %s", ErrCatalogDuplicate, rec.Name)
+		} else if err != ErrIngredientNotFound {
+			return err
+		}
+		_, err := writeIngredient(tx, rec, actor, checkEnergy)
+		return err
+	})
+}
+
+// UpdateIngredient replaces the macros of an existing row. Optional fields
+// left out keep their stored values, as writeIngredient describes.
+func UpdateIngredient(rec config.IngredientRecord, actor string, checkEnergy bool) error {
+	return withCatalogTx(false, func(tx *sql.Tx) error {
+		if _, err := getIngredientRecord(tx, rec.Name); err != nil {
+			return err
+		}
+		_, err := writeIngredient(tx, rec, actor, checkEnergy)
+		return err
+	})
+}
+
+// UpsertOptions controls UpsertIngredients.
+type UpsertOptions struct {
+	Actor       string
+	CheckEnergy bool
+	// SkipInvalid leaves out records that fail validation instead of
+	// rejecting the whole batch.
+	SkipInvalid bool
+	// DryRun rolls the transaction back once every record is written.
+	DryRun bool
+}
+
+type SkippedRecord struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+type UpsertResult struct {
+	Created   int             `json:"created"`
+	Updated   int             `json:"updated"`
+	Unchanged int             `json:"unchanged"`
+	Skipped   []SkippedRecord `json:"skipped,omitempty"`
+}
+
+// UpsertIngredients creates or updates every record in one transaction.
+// Unless SkipInvalid is set, a single invalid record leaves the catalogue
+// untouched.
+func UpsertIngredients(records []config.IngredientRecord, opts UpsertOptions) (UpsertResult, error) {
+	var result UpsertResult
+	err := withCatalogTx(opts.DryRun, func(tx *sql.Tx) error {
+		for i, rec := range records {
+			outcome, err := writeIngredient(tx, rec, opts.Actor, opts.CheckEnergy)
+			if errors.Is(err, ErrInvalidIngredient) && opts.SkipInvalid {
+				result.Skipped = append(result.Skipped, SkippedRecord{Name: rec.Name, Reason: err.Error()})
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("record %d: %w", i+1, err)
+			}
+			switch outcome {
+			case writeCreated:
+				result.Created++
+			case writeUpdated:
+				result.Updated++
+			default:
+				result.Unchanged++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return UpsertResult{}, err
+	}
+	return result, nil
+}
+
+// DeleteIngredient removes a row with its nutrients, portions, taxonomy
+// and aliases. Ingredients still used by a recipe, a diary entry or a
+// pantry lot are kept. Meal plans only reference recipes, so an ingredient
+// in a planned meal is caught by the recipe check.
+func DeleteIngredient(name, actor string) error {
+	return withCatalogTx(false, func(tx *sql.Tx) error {
+		before, err := getIngredientRecord(tx, name)
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(`
+            SELECT DISTINCT r.name FROM recipe_ingredients ri
+            JOIN recipes r ON r.id = ri.recipe_id
+            WHERE LOWER(ri.ingredient_name) = LOWER(?)
+            ORDER BY r.name`, before.Name)
+		if err != nil {
+			return err
+		}
+		var recipes []string
+		for rows.Next() {
+			var recipe string
+			if err := rows.Scan(&recipe); err != nil {
+				rows.Close()
+				return err
+			}
+			recipes = append(recipes, recipe)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		inUse := IngredientInUseError{Recipes: recipes}
+		if err := tx.QueryRow(`
+            SELECT COUNT(DISTINCT entry_id) FROM diary_entry_ingredients
+            WHERE LOWER(ingredient_name) = LOWER(?)`, before.Name).Scan(&inUse.DiaryEntries); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(`
+            SELECT COUNT(*) FROM pantry_items
+            WHERE ingredient_name = ?`, before.Name).Scan(&inUse.PantryLots); err != nil {
+			return err
+		}
+		if len(inUse.Recipes) > 0 || inUse.DiaryEntries > 0 || inUse.PantryLots > 0 {
+			return &inUse
+		}
+
+		for _, stmt := range []string{
+			"DELETE FROM ingredient_nutrients WHERE ingredient_name = ?",
+			"DELETE FROM ingredient_portions WHERE ingredient_name = ?",
+			"DELETE FROM ingredient_allergens WHERE ingredient_name = ?",
+			"DELETE FROM ingredient_metadata WHERE ingredient_name = ?",
+			"DELETE FROM ingredient_aliases WHERE ingredient_name = ?",
+			"DELETE FROM ingredient_prices WHERE ingredient_name = ?",
+			"DELETE FROM ingredients WHERE NAME = ?",
+		} {
+			if _, err := tx.Exec(stmt, before.Name); err != nil {
+				return err
+			}
+		}
+		return audit(tx, before.Name, "delete", actor, &before, nil)
+	})
+}
+
+type writeResult int
+
+const (
+	writeCreated writeResult = iota
+	writeUpdated
+	writeUnchanged
+)
+
+// writeIngredient validates rec and inserts or updates it. A record with
+// an FDC ID updates the row imported from that food, whatever its name.
+// Unchanged rows are neither rewritten nor audited. When a row is updated,
+// every optional field left at its zero value (density, source, FDC ID and
+// a nil Nutrients map) keeps the stored value; an empty Nutrients map
+// removes the micronutrients.
+func writeIngredient(tx *sql.Tx, rec config.IngredientRecord, actor string, checkEnergy bool) (writeResult, error) {
+	rec.Name = strings.TrimSpace(rec.Name)
+	if err := ValidateIngredientRecord(rec, checkEnergy); err != nil {
+		return 0, err
+	}
+	if err := validateNutrients(tx, rec.Nutrients, ErrInvalidIngredient); err != nil {
+		return 0, err
+	}
+
+	name := rec.Name
+	if rec.FDCID != 0 {
+		err := tx.QueryRow("SELECT NAME FROM ingredients WHERE FDC_ID = ?", rec.FDCID).Scan(&name)
+		if err != nil && err != sql.ErrNoRows {
+			return 0, err
+		}
+	}
+	before, err := getIngredientRecord(tx, name)
+	isNew := err == ErrIngredientNotFound
+	if err != nil && !isNew {
+		return 0, err
+	}
+	if !isNew && rec.FDCID != 0 && before.FDCID != 0 && before.FDCID != rec.FDCID {
+		return 0, fmt.Errorf("%w: %s is already imported from FDC %d", ErrInvalidIngredient, before.Name, before.FDCID)
+	}
+
+	if isNew {
+		_, err = tx.Exec("INSERT INTO ingredients (NAME, CALORIES, PROTEINS, CARBS, FATS, FIBER, DENSITY, SOURCE, FDC_ID) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			rec.Name, rec.Calories, rec.Proteins, rec.Carbs, rec.Fats, rec.Fiber, nullableDensity(rec.Density),
+			nullableString(rec.Source), nullableFDCID(rec.FDCID))
+	} else {
+		// Keep the stored spelling so references stay valid.
+		rec.Name = before.Name
+		if rec.Density == 0 {
+			rec.Density = before.Density
+		}
+		if rec.Nutrients == nil {
+			rec.Nutrients = before.Nutrients
+		}
+		if rec.Source == "" {
+			rec.Source = before.Source
+		}
+		if rec.FDCID == 0 {
+			rec.FDCID = before.FDCID
+		}
+		if sameRecord(before, rec) {
+			return writeUnchanged, nil
+		}
+		_, err = tx.Exec("UPDATE ingredients SET CALORIES = ?, PROTEINS = ?, CARBS = ?, FATS = ?, FIBER = ?, DENSITY = ?, SOURCE = ?, FDC_ID = ? WHERE NAME = ?",
+			rec.Calories, rec.Proteins, rec.Carbs, rec.Fats, rec.Fiber, nullableDensity(rec.Density),
+			nullableString(rec.Source), nullableFDCID(rec.FDCID), rec.Name)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec("DELETE FROM ingredient_nutrients WHERE ingredient_name = ?", rec.Name); err != nil {
+		return 0, err
+	}
+	keys := make([]string, 0, len(rec.Nutrients))
+	for key := range rec.Nutrients {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, err := tx.Exec("INSERT INTO ingredient_nutrients (ingredient_name, nutrient_key, amount) VALUES (?, ?, ?)",
+			rec.Name, key, rec.Nutrients[key]); err != nil {
+			return 0, err
+		}
+	}
+
+	if isNew {
+		return writeCreated, audit(tx, rec.Name, "create", actor, nil, &rec)
+	}
+	return writeUpdated, audit(tx, rec.Name, "update", actor, &before, &rec)
+}
+
+// withCatalogTx runs fn in a transaction and tells the listeners about the
+// change. A dry run is rolled back instead.
+func withCatalogTx(dryRun bool, fn func(tx *sql.Tx) error) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if dryRun {
+		return nil
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	notifyIngredientChange()
+	return nil
+}
+
+func audit(tx *sql.Tx, name, action, actor string, before, after *config.IngredientRecord) error {
+	encode := func(rec *config.IngredientRecord) (sql.NullString, error) {
+		if rec == nil {
+			return sql.NullString{}, nil
+		}
+		data, err := json.Marshal(rec)
+		return sql.NullString{String: string(data), Valid: true}, err
+	}
+	beforeData, err := encode(before)
+	if err != nil {
+		return err
+	}
+	afterData, err := encode(after)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("INSERT INTO ingredient_audit (ingredient_name, action, actor, before_data, after_data, changed_at) VALUES (?, ?, ?, ?, ?, ?)",
+		name, action, actor, beforeData, afterData, time.Now().UTC())
+	return err
+}
+
+// ListIngredientAudit returns the newest changes first, optionally only
+// those to one ingredient.
+func ListIngredientAudit(name string, limit int) ([]config.IngredientAuditEntry, error) {
+	query := "SELECT id, ingredient_name, action, actor, before_data, after_data, changed_at FROM ingredient_audit"
+	var args []interface{}
+	if name != "" {
+		query += " WHERE ingredient_name = ?"
+		args = append(args, name)
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []config.IngredientAuditEntry{}
+	for rows.Next() {
+		var e config.IngredientAuditEntry
+		var before, after sql.NullString
+		if err := rows.Scan(&e.ID, &e.Ingredient, &e.Action, &e.Actor, &before, &after, &e.ChangedAt); err != nil {
+			return nil, fmt.Errorf("scanning audit entry failed: %w", err)
+		}
+		if e.Before, err = decodeRecord(before); err != nil {
+			return nil, err
+		}
+		if e.After, err = decodeRecord(after); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func decodeRecord(data sql.NullString) (*config.IngredientRecord, error) {
+	if !data.Valid {
+		return nil, nil
+	}
+	var rec config.IngredientRecord
+	if err := json.Unmarshal([]byte(data.String), &rec); err != nil {
+		return nil, fmt.Errorf("decoding audit record failed: %w", err)
+	}
+	return &rec, nil
+}
+
+func sameRecord(a, b config.IngredientRecord) bool {
+	if a.Calories != b.Calories || a.Proteins != b.Proteins || a.Carbs != b.Carbs ||
+		a.Fats != b.Fats || a.Fiber != b.Fiber || a.Density != b.Density || a.Source != b.Source ||
+		a.FDCID != b.FDCID {
+		return false
+	}
+	return sameNutrients(a.Nutrients, b.Nutrients)
+}
+
+func sameNutrients(a, b config.Nutrients) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, amount := range a {
+		if other, ok := b[key]; !ok || other != amount {
+			return false
+		}
+	}
+	return true
+}
+
+func nullableDensity(density float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: density, Valid: density > 0}
+}
+
+func nullableString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func nullableFDCID(id int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id > 0}
+}
@@ -8,16 +8,27 @@ package database
 import (
 	"FoodStats/internal/config"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/mattn/go-sqlite3"
 )
 
 var DB *sql.DB
 
+var (
+	ErrIngredientNotFound = errors.New("ingredient not found")
+	ErrRecipeNotFound     = errors.New("recipe not found")
+	ErrRecipeExists       = errors.New("a recipe with this name already exists")
+	ErrInvalidRecipe      = errors.New("invalid recipe")
+	ErrRecipeInUse        = errors.New("recipe is used by a meal plan")
+)
+
 func monitorDBStats(logger *log.Logger) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -33,36 +44,47 @@ func monitorDBStats(logger *log.Logger) {
 }
 
 func InitDB() error {
-	var err error
 	logger := log.New(os.Stdout, "[DB] ", log.LstdFlags|log.Lshortfile)
 
-	possiblePaths := []string{
-		"nutrition_data.db",
-		"./database/nutrition_data.db",
-		"./internal/database/nutrition_data.db",
-		"./backend/database/nutrition_data.db",
-		"./resources/backend/database/nutrition_data.db",
-		"../backend/database/nutrition_data.db",
-		"./database/nutrition_data.db",
-	}
-
-	var dbPath string
-	for _, path := range possiblePaths {
-		if _, err := os.Stat(path); !os.IsNotExist(err) {
-			dbPath = path
-			logger.Printf("Found database at: %s", path)
-			break
-		}
+	dbPath, err := OpenDB(logger)
+	if err != nil {
+		return err
 	}
 
-	if dbPath == "" {
-		log.Fatal("Could not find database file in any location")
+	applied, err := MigrateUp(DB, 0, false)
+	if err != nil {
+		logger.Printf("Database migration failed: %v", err)
+		return err
+	}
+	for _, mig := range applied {
+		logger.Printf("Applied migration %04d_%s", mig.Version, mig.Name)
 	}
 
+	go monitorDBStats(logger)
+
+	logger.Printf("Database connected successfully at: %s", dbPath)
+	return nil
+}
+
+func OpenDB(logger *log.Logger) (string, error) {
+	dbPath, err := config.GetDBPath()
+	if err != nil {
+		return "", fmt.Errorf("resolving database path failed: %w", err)
+	}
+	if dbPath, err = filepath.Abs(dbPath); err != nil {
+		return "", err
+	}
+
+	if err := ensureDatabaseFile(dbPath, logger); err != nil {
+		logger.Printf("Database unavailable: %v", err)
+		return "", err
+	}
+	logger.Printf("Using database at: %s", dbPath)
+
 	DB, err = sql.Open("sqlite3", dbPath)
 	if err != nil {
-		logger.Fatal("DB connect error:", err)
-		return err
+		logger.Println("DB connect error:", err)
+		return "", err
 	}
 
 	DB.SetMaxOpenConns(25)
@@ -80,14 +102,11 @@ func InitDB() error {
 	}
 
 	if err != nil {
-		logger.Fatal("Failed to connect to database after 4 attempts:", err)
-		return err
+		logger.Println("Failed to connect to database after 4 attempts:", err)
+		return "", err
 	}
 
-	go monitorDBStats(logger)
-
-	logger.Printf("Database connected successfully at: %s", dbPath)
-	return nil
+	return dbPath, nil
 }
 
 func CloseDB() {
@@ -98,9 +117,20 @@ func CloseDB() {
 }
 
 func ReturnIngredient(ingredient config.TemplateIngredient) (config.Ingredient, error) {
-	query := `SELECT CALORIES, PROTEINS, CARBS, FATS, FIBER FROM ingredients WHERE LOWER(NAME) = LOWER(?)`
+	canonical, err := ResolveIngredientName(ingredient.Name)
+	if err != nil {
+		if err == ErrIngredientNotFound {
+			log.Printf("Ingredient '%s' not found in the database.\n", ingredient.Name)
+		}
+		return config.Ingredient{}, err
+	}
+	if !strings.EqualFold(canonical, ingredient.Name) {
+		ingredient.Name = strings.ToLower(canonical)
+	}
+
+	query := `SELECT CALORIES, PROTEINS, CARBS, FATS, FIBER FROM ingredients WHERE NAME = ?`
 	var ingredientDataPerCent config.Ingredient
-	err := DB.QueryRow(query, ingredient.Name).Scan(
+	err = DB.QueryRow(query, canonical).Scan(
 		&ingredientDataPerCent.Calories,
 		&ingredientDataPerCent.Proteins,
 		&ingredientDataPerCent.Carbs,
@@ -110,131 +140,345 @@ func ReturnIngredient(ingredient config.TemplateIngredient) (config.Ingredient,
 	if err != nil {
 		if err == sql.ErrNoRows {
 			log.Printf("Ingredient '%s' not found in the database.\n", ingredient.Name)
-			return config.Ingredient{}, fmt.Errorf("ingredient not found")
+			return config.Ingredient{}, ErrIngredientNotFound
 		}
 		log.Println("Error executing query:", err)
 		return config.Ingredient{}, err
 	}
 
 	var data config.Ingredient
-	data.Name = ingredient.Name
-	data.Grams = ingredient.Grams
+	data.TemplateIngredient = ingredient
 	data.Calories = data.Grams * ingredientDataPerCent.Calories / 100
 	data.Proteins = data.Grams * ingredientDataPerCent.Proteins / 100
 	data.Carbs = data.Grams * ingredientDataPerCent.Carbs / 100
 	data.Fats = data.Grams * ingredientDataPerCent.Fats / 100
 	data.Fiber = data.Grams * ingredientDataPerCent.Fiber / 100
 
+	nutrientsPerCent, err := ingredientNutrients(canonical)
+	if err != nil {
+		log.Println("Error loading nutrients:", err)
+		return config.Ingredient{}, err
+	}
+	data.Nutrients = nutrientsPerCent.Scaled(data.Grams / 100)
+	if len(nutrientsPerCent) == 0 {
+		data.MissingNutrients = []string{data.Name}
+	}
+
 	return data, nil
 }
 
-func GetAllIngredients() []config.Ingredient {
+func ListIngredientNames() ([]string, error) {
 	rows, err := DB.Query("SELECT name FROM ingredients")
 	if err != nil {
-		log.Println("Error fetching ingredients:", err)
-		return nil
+		return nil, err
 	}
 	defer rows.Close()
 
-	var list []config.Ingredient
+	var names []string
 	for rows.Next() {
 		var name string
 		if err := rows.Scan(&name); err != nil {
-			log.Printf("Error scanning ingredient: %v", err)
-			continue
+			return nil, fmt.Errorf("scanning ingredient failed: %w", err)
 		}
-		list = append(list, config.Ingredient{
-			TemplateIngredient: config.TemplateIngredient{
-				Name: name,
-			},
-		})
+		names = append(names, name)
 	}
-	return list
+	return names, rows.Err()
 }
 
 func GetRecipe(name string) (config.Recipe, error) {
+	return getRecipeWhere("name = ?", name)
+}
+
+func GetRecipeByID(id int) (config.Recipe, error) {
+	return getRecipeWhere("id = ?", id)
+}
+
+func getRecipeWhere(cond string, arg interface{}) (config.Recipe, error) {
 	var recipe config.Recipe
-	err := DB.QueryRow("SELECT id, name, description, vegan FROM recipes WHERE name = ?", name).
-		Scan(&recipe.ID, &recipe.Name, &recipe.Description, &recipe.Vegan)
+	var yieldGrams sql.NullFloat64
+	err := DB.QueryRow("SELECT id, name, description, servings, yield_grams FROM recipes WHERE "+cond, arg).
+		Scan(&recipe.ID, &recipe.Name, &recipe.Description, &recipe.Servings, &yieldGrams)
+	if err == sql.ErrNoRows {
+		return recipe, ErrRecipeNotFound
+	}
 	if err != nil {
 		return recipe, err
 	}
+	recipe.YieldGrams = yieldGrams.Float64
 
-	rows, err := DB.Query("SELECT ingredient_name, grams FROM recipe_ingredients WHERE recipe_id = ?", recipe.ID)
+	recipe.Ingredients, err = getRecipeIngredients(recipe.ID)
 	if err != nil {
 		return recipe, err
 	}
-	defer rows.Close()
+	recipe.ComputeNutrition()
+	if recipe.Cost, err = RecipeCost(recipe, config.GetCurrency()); err != nil {
+		return recipe, err
+	}
+
+	taxonomy, err := IngredientTaxonomy()
+	if err != nil {
+		return recipe, err
+	}
+	applyDietFlags(&recipe, taxonomy)
+	return recipe, nil
+}
 
+// LoadRecipes loads the recipes with the given IDs or names, with their
+// nutrition and diet flags but without a cost. The taxonomy is read once
+// for all of them. Recipes that do not exist are left out.
+func LoadRecipes(ids []int, names []string) ([]config.Recipe, error) {
+	if len(ids) == 0 && len(names) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, 0, len(ids)+len(names))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	for _, name := range names {
+		args = append(args, strings.ToLower(name))
+	}
+	idList := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	nameList := strings.TrimSuffix(strings.Repeat("?,", len(names)), ",")
+
+	rows, err := DB.Query(`
+        SELECT id, name, description, servings, yield_grams
+        FROM recipes
+        WHERE id IN (`+idList+`) OR LOWER(name) IN (`+nameList+`)`, args...)
+	if err != nil {
+		return nil, err
+	}
+	var recipes []config.Recipe
 	for rows.Next() {
-		var data config.TemplateIngredient
-		if err := rows.Scan(&data.Name, &data.Grams); err == nil {
-			recipe.Ingredients = append(recipe.Ingredients, config.Ingredient{
-				TemplateIngredient: data,
-			})
+		var r config.Recipe
+		var yieldGrams sql.NullFloat64
+		if err := rows.Scan(&r.ID, &r.Name, &r.Description, &r.Servings, &yieldGrams); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning recipe failed: %w", err)
 		}
+		r.YieldGrams = yieldGrams.Float64
+		recipes = append(recipes, r)
 	}
-	return recipe, nil
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	taxonomy, err := IngredientTaxonomy()
+	if err != nil {
+		return nil, err
+	}
+	for i := range recipes {
+		if recipes[i].Ingredients, err = getRecipeIngredients(recipes[i].ID); err != nil {
+			return nil, err
+		}
+		recipes[i].ComputeNutrition()
+		applyDietFlags(&recipes[i], taxonomy)
+	}
+	return recipes, nil
 }
 
-func AddRecipe(name, description string, ingredients []config.TemplateIngredient) error {
-	if !ValidateIngredientName(name) {
-		return fmt.Errorf("invalid recipe name")
+func validateRecipe(recipe config.Recipe) (string, error) {
+	if !ValidateIngredientName(recipe.Name) {
+		return "", fmt.Errorf("%w: invalid recipe name", ErrInvalidRecipe)
 	}
 
-	sanitizedDesc := SanitizeDescription(description)
+	sanitizedDesc := SanitizeDescription(recipe.Description)
 	if len(sanitizedDesc) > 500 {
-		return fmt.Errorf("description too long")
+		return "", fmt.Errorf("%w: description too long", ErrInvalidRecipe)
 	}
 
-	tx, err := DB.Begin()
+	if !ValidateServings(recipe.Servings) {
+		return "", fmt.Errorf("%w: invalid number of servings", ErrInvalidRecipe)
+	}
+	if recipe.YieldGrams != 0 && !ValidateGrams(recipe.YieldGrams) {
+		return "", fmt.Errorf("%w: invalid yield", ErrInvalidRecipe)
+	}
+
+	// Ingredients have been matched to the catalogue by now, so their names
+	// follow its rules, accents included.
+	for _, ing := range recipe.Ingredients {
+		if !ValidateCatalogName(ing.Name) {
+			return "", fmt.Errorf("%w: invalid ingredient name: %s", ErrInvalidRecipe, ing.Name)
+		}
+		if !ValidateGrams(ing.Grams) {
+			return "", fmt.Errorf("%w: invalid grams amount for %s", ErrInvalidRecipe, ing.Name)
+		}
+	}
+	return sanitizedDesc, nil
+}
+
+func nullableGrams(grams float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: grams, Valid: grams > 0}
+}
+
+func recipeWriteError(err error) error {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+		return ErrRecipeExists
+	}
+	return err
+}
+
+func replaceRecipeIngredients(tx *sql.Tx, recipeID int64, ingredients []config.Ingredient) error {
+	if _, err := tx.Exec("DELETE FROM recipe_ingredients WHERE recipe_id = ?", recipeID); err != nil {
+		return err
+	}
+
+	ingredientStmt, err := tx.Prepare("INSERT INTO recipe_ingredients (recipe_id, ingredient_name, grams) VALUES (?, ?, ?)")
 	if err != nil {
 		return err
 	}
+	defer ingredientStmt.Close()
+
+	for _, ing := range ingredients {
+		if _, err := ingredientStmt.Exec(recipeID, ing.Name, ing.Grams); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func AddRecipe(recipe config.Recipe) (int, error) {
+	if recipe.Servings == 0 {
+		recipe.Servings = 1
+	}
+	ingredients, err := canonicalizeIngredients(recipe.Ingredients)
+	if err != nil {
+		return 0, err
+	}
+	recipe.Ingredients = ingredients
+
+	sanitizedDesc, err := validateRecipe(recipe)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, err
+	}
 	defer tx.Rollback()
 
-	stmt, err := tx.Prepare("INSERT INTO recipes (name, description) VALUES (?, ?)")
+	res, err := tx.Exec("INSERT INTO recipes (name, description, servings, yield_grams) VALUES (?, ?, ?, ?)",
+		recipe.Name, sanitizedDesc, recipe.Servings, nullableGrams(recipe.YieldGrams))
 	if err != nil {
-		return err
+		return 0, recipeWriteError(err)
+	}
+
+	recipeID, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
 	}
-	defer stmt.Close()
 
-	res, err := stmt.Exec(name, sanitizedDesc)
+	if err := replaceRecipeIngredients(tx, recipeID, recipe.Ingredients); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	notifyRecipeChange(int(recipeID))
+	return int(recipeID), nil
+}
+
+// UpdateRecipe overwrites a recipe's fields. A nil Ingredients slice keeps
+// the current ingredient list; otherwise it is replaced wholesale.
+func UpdateRecipe(id int, recipe config.Recipe) error {
+	ingredients, err := canonicalizeIngredients(recipe.Ingredients)
 	if err != nil {
 		return err
 	}
+	recipe.Ingredients = ingredients
 
-	recipeID, err := res.LastInsertId()
+	sanitizedDesc, err := validateRecipe(recipe)
 	if err != nil {
 		return err
 	}
 
-	ingredientStmt, err := tx.Prepare("INSERT INTO recipe_ingredients (recipe_id, ingredient_name, grams) VALUES (?, ?, ?)")
+	tx, err := DB.Begin()
 	if err != nil {
 		return err
 	}
-	defer ingredientStmt.Close()
+	defer tx.Rollback()
 
-	for _, ing := range ingredients {
-		if !ValidateIngredientName(ing.Name) {
-			return fmt.Errorf("invalid ingredient name: %s", ing.Name)
-		}
-		if !ValidateGrams(ing.Grams) {
-			return fmt.Errorf("invalid grams amount for %s", ing.Name)
-		}
+	res, err := tx.Exec("UPDATE recipes SET name = ?, description = ?, servings = ?, yield_grams = ? WHERE id = ?",
+		recipe.Name, sanitizedDesc, recipe.Servings, nullableGrams(recipe.YieldGrams), id)
+	if err != nil {
+		return recipeWriteError(err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrRecipeNotFound
+	}
 
-		_, err := ingredientStmt.Exec(recipeID, ing.Name, ing.Grams)
-		if err != nil {
+	if recipe.Ingredients != nil {
+		if err := replaceRecipeIngredients(tx, int64(id), recipe.Ingredients); err != nil {
 			return err
 		}
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	notifyRecipeChange(id)
+	return nil
+}
+
+// DeleteRecipe removes a recipe and its ingredients. Diary entries that
+// logged it keep their copy of the ingredients and are detached from it; a
+// recipe that a meal plan still uses cannot be deleted.
+func DeleteRecipe(id int) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRow("SELECT EXISTS(SELECT 1 FROM recipes WHERE id = ?)", id).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return ErrRecipeNotFound
+	}
+
+	var planned bool
+	if err := tx.QueryRow("SELECT EXISTS(SELECT 1 FROM meal_plan_meals WHERE recipe_id = ?)", id).Scan(&planned); err != nil {
+		return err
+	}
+	if planned {
+		return ErrRecipeInUse
+	}
+
+	if _, err := tx.Exec("UPDATE diary_entries SET recipe_id = NULL WHERE recipe_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM recipe_ingredients WHERE recipe_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM recipes WHERE id = ?", id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	notifyRecipeChange(id)
+	return nil
 }
 
 func ListRecipes() ([]config.Recipe, error) {
+	return listRecipes(false)
+}
+
+// ListRecipesWithNutrition is ListRecipes with each ingredient's nutrition
+// and the recipe totals filled in.
+func ListRecipesWithNutrition() ([]config.Recipe, error) {
+	return listRecipes(true)
+}
+
+func listRecipes(withNutrition bool) ([]config.Recipe, error) {
 	rows, err := DB.Query(`
-        SELECT r.id, r.name, r.description, r.vegan
+        SELECT r.id, r.name, r.description, r.servings, r.yield_grams
         FROM recipes r 
         ORDER BY r.name ASC`)
 	if err != nil {
@@ -242,28 +486,39 @@ func ListRecipes() ([]config.Recipe, error) {
 	}
 	defer rows.Close()
 
+	taxonomy, err := IngredientTaxonomy()
+	if err != nil {
+		return nil, err
+	}
+
 	var recipes []config.Recipe
 	for rows.Next() {
 		var r config.Recipe
-		if err := rows.Scan(&r.ID, &r.Name, &r.Description, &r.Vegan); err != nil {
+		var yieldGrams sql.NullFloat64
+		if err := rows.Scan(&r.ID, &r.Name, &r.Description, &r.Servings, &yieldGrams); err != nil {
 			log.Printf("Error scanning recipe: %v", err)
 			continue
 		}
+		r.YieldGrams = yieldGrams.Float64
 
 		ingredients, err := getRecipeIngredients(r.ID)
 		if err != nil {
 			log.Printf("Error getting ingredients for recipe %s: %v", r.Name, err)
 			continue
 		}
-		//r.Ingredients = ingredients
-
-		var ingredientList []config.Ingredient
-		for _, ing := range ingredients {
-			ingredientList = append(ingredientList, config.Ingredient{
-				TemplateIngredient: ing.TemplateIngredient,
-			})
+		if withNutrition {
+			r.Ingredients = ingredients
+			r.ComputeNutrition()
+		} else {
+			var ingredientList []config.Ingredient
+			for _, ing := range ingredients {
+				ingredientList = append(ingredientList, config.Ingredient{
+					TemplateIngredient: ing.TemplateIngredient,
+				})
+			}
+			r.Ingredients = ingredientList
 		}
-		r.Ingredients = ingredientList
+		applyDietFlags(&r, taxonomy)
 
 		recipes = append(recipes, r)
 	}
@@ -285,7 +540,13 @@ func getRecipeIngredients(recipeID int) ([]config.Ingredient, error) {
             i.FIBER 
         FROM recipe_ingredients ri
         JOIN ingredients i ON LOWER(ri.ingredient_name) = LOWER(i.NAME)
-        WHERE ri.recipe_id = ?`
+        WHERE ri.recipe_id = ?
+        ORDER BY ri.rowid`
+
+	extra, err := recipeNutrients(recipeID)
+	if err != nil {
+		return nil, err
+	}
 
 	rows, err := DB.Query(query, recipeID)
 	if err != nil {
@@ -303,19 +564,24 @@ func getRecipeIngredients(recipeID int) ([]config.Ingredient, error) {
 			return nil, fmt.Errorf("scanning recipe ingredient failed: %w", err)
 		}
 
-		ingredients = append(ingredients, config.Ingredient{
+		ing := config.Ingredient{
 			TemplateIngredient: config.TemplateIngredient{
 				Name:  ingName,
 				Grams: ingGrams,
 			},
 			NutritionalInfo: config.NutritionalInfo{
-				Calories: ingGrams * baseCalories / 100,
-				Proteins: ingGrams * baseProteins / 100,
-				Carbs:    ingGrams * baseCarbs / 100,
-				Fats:     ingGrams * baseFats / 100,
-				Fiber:    ingGrams * baseFiber / 100,
+				Calories:  ingGrams * baseCalories / 100,
+				Proteins:  ingGrams * baseProteins / 100,
+				Carbs:     ingGrams * baseCarbs / 100,
+				Fats:      ingGrams * baseFats / 100,
+				Fiber:     ingGrams * baseFiber / 100,
+				Nutrients: extra[strings.ToLower(ingName)].Scaled(ingGrams / 100),
 			},
-		})
+		}
+		if len(ing.Nutrients) == 0 {
+			ing.MissingNutrients = []string{ingName}
+		}
+		ingredients = append(ingredients, ing)
 	}
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows iteration error for recipe ingredients: %w", err)
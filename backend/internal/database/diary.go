@@ -0,0 +1,181 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrDiaryEntryNotFound = errors.New("diary entry not found")
+
+func AddDiaryEntry(sessionID string, entry config.DiaryEntry) (config.DiaryEntry, error) {
+	entry.CreatedAt = time.Now().UTC()
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return entry, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+        INSERT INTO diary_entries (session_id, entry_date, meal, recipe_id, recipe_name, portion, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		sessionID, entry.Date, entry.Meal,
+		sql.NullInt64{Int64: int64(entry.RecipeID), Valid: entry.RecipeID > 0},
+		sql.NullString{String: entry.RecipeName, Valid: entry.RecipeName != ""},
+		sql.NullFloat64{Float64: entry.Portion, Valid: entry.Portion > 0},
+		entry.CreatedAt)
+	if err != nil {
+		return entry, fmt.Errorf("saving diary entry failed: %w", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return entry, err
+	}
+	entry.ID = int(id)
+
+	stmt, err := tx.Prepare(`
+        INSERT INTO diary_entry_ingredients (entry_id, position, ingredient_name, grams, data)
+        VALUES (?, ?, ?, ?, ?)`)
+	if err != nil {
+		return entry, err
+	}
+	defer stmt.Close()
+
+	for i, ing := range entry.Ingredients {
+		data, err := json.Marshal(ing)
+		if err != nil {
+			return entry, err
+		}
+		if _, err := stmt.Exec(id, i, ing.Name, ing.Grams, string(data)); err != nil {
+			return entry, fmt.Errorf("saving diary ingredient failed: %w", err)
+		}
+	}
+
+	entry.Nutrition = config.SumIngredients(entry.Ingredients).NutritionalInfo
+	return entry, tx.Commit()
+}
+
+func ListDiaryEntries(sessionID, date string) ([]config.DiaryEntry, error) {
+	rows, err := DB.Query(`
+        SELECT id, entry_date, meal, recipe_id, recipe_name, portion, created_at
+        FROM diary_entries
+        WHERE session_id = ? AND entry_date = ?
+        ORDER BY CASE meal
+            WHEN 'breakfast' THEN 0 WHEN 'lunch' THEN 1 WHEN 'dinner' THEN 2 ELSE 3 END,
+            created_at ASC, id ASC`, sessionID, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]config.DiaryEntry, 0)
+	index := make(map[int]int)
+	for rows.Next() {
+		var entry config.DiaryEntry
+		var recipeID sql.NullInt64
+		var recipeName sql.NullString
+		var portion sql.NullFloat64
+		if err := rows.Scan(&entry.ID, &entry.Date, &entry.Meal, &recipeID, &recipeName, &portion, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning diary entry failed: %w", err)
+		}
+		entry.RecipeID = int(recipeID.Int64)
+		entry.RecipeName = recipeName.String
+		entry.Portion = portion.Float64
+		entry.Ingredients = make([]config.Ingredient, 0)
+		index[entry.ID] = len(entries)
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	ingRows, err := DB.Query(`
+        SELECT di.entry_id, di.data
+        FROM diary_entry_ingredients di
+        JOIN diary_entries d ON d.id = di.entry_id
+        WHERE d.session_id = ? AND d.entry_date = ?
+        ORDER BY di.entry_id, di.position`, sessionID, date)
+	if err != nil {
+		return nil, err
+	}
+	defer ingRows.Close()
+
+	for ingRows.Next() {
+		var entryID int
+		var data string
+		if err := ingRows.Scan(&entryID, &data); err != nil {
+			return nil, fmt.Errorf("scanning diary ingredient failed: %w", err)
+		}
+		var ing config.Ingredient
+		if err := json.Unmarshal([]byte(data), &ing); err != nil {
+			return nil, fmt.Errorf("decoding diary ingredient failed: %w", err)
+		}
+		if i, ok := index[entryID]; ok {
+			entries[i].Ingredients = append(entries[i].Ingredients, ing)
+		}
+	}
+	if err := ingRows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Nutrition = config.SumIngredients(entries[i].Ingredients).NutritionalInfo
+	}
+	return entries, nil
+}
+
+func DeleteDiaryEntry(sessionID string, id int) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM diary_entries WHERE id = ? AND session_id = ?", id, sessionID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrDiaryEntryNotFound
+	}
+
+	if _, err := tx.Exec("DELETE FROM diary_entry_ingredients WHERE entry_id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func DiaryDaySummary(sessionID, date string) (config.DaySummary, error) {
+	entries, err := ListDiaryEntries(sessionID, date)
+	if err != nil {
+		return config.DaySummary{}, err
+	}
+
+	summary := config.DaySummary{
+		Date:    date,
+		Entries: len(entries),
+		Meals:   make(map[string]config.NutritionalInfo),
+	}
+
+	var all []config.Ingredient
+	for _, entry := range entries {
+		meal := summary.Meals[entry.Meal]
+		meal.Add(entry.Nutrition)
+		summary.Meals[entry.Meal] = meal
+		all = append(all, entry.Ingredients...)
+	}
+	summary.Total = config.SumIngredients(all)
+	summary.Total.Name = "Daily total"
+	return summary, nil
+}
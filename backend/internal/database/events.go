@@ -0,0 +1,52 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import "sync"
+
+var (
+	recipeListenersMu sync.RWMutex
+	recipeListeners   []func(id int)
+
+	ingredientListenersMu sync.RWMutex
+	ingredientListeners   []func()
+)
+
+// OnRecipeChange registers fn to run after a recipe is added, updated or
+// deleted. Listeners look the recipe up again to see which it was.
+func OnRecipeChange(fn func(id int)) {
+	recipeListenersMu.Lock()
+	defer recipeListenersMu.Unlock()
+
+	recipeListeners = append(recipeListeners, fn)
+}
+
+func notifyRecipeChange(id int) {
+	recipeListenersMu.RLock()
+	defer recipeListenersMu.RUnlock()
+
+	for _, fn := range recipeListeners {
+		fn(id)
+	}
+}
+
+// OnIngredientChange registers fn to run after ingredients are added,
+// renamed or removed.
+func OnIngredientChange(fn func()) {
+	ingredientListenersMu.Lock()
+	defer ingredientListenersMu.Unlock()
+
+	ingredientListeners = append(ingredientListeners, fn)
+}
+
+func notifyIngredientChange() {
+	ingredientListenersMu.RLock()
+	defer ingredientListenersMu.RUnlock()
+
+	for _, fn := range ingredientListeners {
+		fn()
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrMealPlanNotFound = errors.New("meal plan not found")
+
+// CreateMealPlan saves a new plan for the session and sets its ID and
+// timestamps.
+func CreateMealPlan(sessionID string, plan *config.MealPlan) error {
+	restrictions, allergies, targets, err := encodePlanSettings(plan)
+	if err != nil {
+		return err
+	}
+	plan.CreatedAt = time.Now().UTC()
+	plan.UpdatedAt = plan.CreatedAt
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+        INSERT INTO meal_plans (session_id, start_date, days, meals, max_repeats, restrictions, allergies, targets, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		sessionID, plan.StartDate, len(plan.Days), strings.Join(plan.Meals, ","), plan.MaxRepeats,
+		restrictions, allergies, targets, plan.CreatedAt, plan.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("saving meal plan failed: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	plan.ID = int(id)
+
+	if err := writePlanMeals(tx, plan); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SaveMealPlanMeals replaces the meals of an existing plan.
+func SaveMealPlanMeals(sessionID string, plan *config.MealPlan) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	plan.UpdatedAt = time.Now().UTC()
+	res, err := tx.Exec("UPDATE meal_plans SET updated_at = ? WHERE id = ? AND session_id = ?",
+		plan.UpdatedAt, plan.ID, sessionID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrMealPlanNotFound
+	}
+
+	if _, err := tx.Exec("DELETE FROM meal_plan_meals WHERE plan_id = ?", plan.ID); err != nil {
+		return err
+	}
+	if err := writePlanMeals(tx, plan); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func writePlanMeals(tx *sql.Tx, plan *config.MealPlan) error {
+	stmt, err := tx.Prepare(`
+        INSERT INTO meal_plan_meals (plan_id, day, meal, recipe_id, recipe_name, portion, locked)
+        VALUES (?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, day := range plan.Days {
+		for _, meal := range day.Meals {
+			if meal.RecipeID == 0 || meal.Portion <= 0 {
+				return fmt.Errorf("saving planned meal failed: day %d %s has no recipe", day.Day, meal.Meal)
+			}
+			if _, err := stmt.Exec(plan.ID, day.Day, meal.Meal, meal.RecipeID, meal.RecipeName, meal.Portion, meal.Locked); err != nil {
+				return fmt.Errorf("saving planned meal failed: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetMealPlan loads a plan with the current nutrition of its recipes. A
+// meal whose recipe has been deleted keeps its name and has no nutrition.
+func GetMealPlan(sessionID string, id int) (config.MealPlan, error) {
+	plans, err := loadMealPlans("WHERE session_id = ? AND id = ?", sessionID, id)
+	if err != nil {
+		return config.MealPlan{}, err
+	}
+	if len(plans) == 0 {
+		return config.MealPlan{}, ErrMealPlanNotFound
+	}
+	return plans[0], nil
+}
+
+// ListMealPlans returns the session's plans, newest first.
+func ListMealPlans(sessionID string) ([]config.MealPlan, error) {
+	return loadMealPlans("WHERE session_id = ?", sessionID)
+}
+
+func loadMealPlans(where string, args ...interface{}) ([]config.MealPlan, error) {
+	rows, err := DB.Query(`
+        SELECT id, start_date, days, meals, max_repeats, restrictions, allergies, targets, created_at, updated_at
+        FROM meal_plans `+where+`
+        ORDER BY created_at DESC, id DESC`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plans := make([]config.MealPlan, 0)
+	index := make(map[int]int)
+	for rows.Next() {
+		var plan config.MealPlan
+		var days int
+		var meals, restrictions, allergies, targets string
+		if err := rows.Scan(&plan.ID, &plan.StartDate, &days, &meals, &plan.MaxRepeats,
+			&restrictions, &allergies, &targets, &plan.CreatedAt, &plan.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scanning meal plan failed: %w", err)
+		}
+		plan.Meals = strings.Split(meals, ",")
+		if err := decodePlanSettings(&plan, restrictions, allergies, targets); err != nil {
+			return nil, err
+		}
+
+		start, err := time.Parse("2006-01-02", plan.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("meal plan %d: %w", plan.ID, err)
+		}
+		plan.Days = make([]config.PlanDay, days)
+		for i := range plan.Days {
+			plan.Days[i] = config.PlanDay{
+				Day:   i + 1,
+				Date:  start.AddDate(0, 0, i).Format("2006-01-02"),
+				Meals: make([]config.PlannedMeal, 0, len(plan.Meals)),
+			}
+		}
+		index[plan.ID] = len(plans)
+		plans = append(plans, plan)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if len(plans) == 0 {
+		return plans, nil
+	}
+
+	ids := make([]interface{}, 0, len(plans))
+	for _, plan := range plans {
+		ids = append(ids, plan.ID)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	mealRows, err := DB.Query(`
+        SELECT plan_id, day, meal, recipe_id, recipe_name, portion, locked
+        FROM meal_plan_meals
+        WHERE plan_id IN (`+placeholders+`)
+        ORDER BY plan_id, day, CASE meal
+            WHEN 'breakfast' THEN 0 WHEN 'lunch' THEN 1 WHEN 'dinner' THEN 2 ELSE 3 END`, ids...)
+	if err != nil {
+		return nil, err
+	}
+	defer mealRows.Close()
+
+	perServing := make(map[int]*config.NutritionalInfo)
+	for mealRows.Next() {
+		var planID, day int
+		var meal config.PlannedMeal
+		if err := mealRows.Scan(&planID, &day, &meal.Meal, &meal.RecipeID, &meal.RecipeName, &meal.Portion, &meal.Locked); err != nil {
+			return nil, fmt.Errorf("scanning planned meal failed: %w", err)
+		}
+		plan := &plans[index[planID]]
+		if day < 1 || day > len(plan.Days) {
+			continue
+		}
+		plan.Days[day-1].Meals = append(plan.Days[day-1].Meals, meal)
+		perServing[meal.RecipeID] = nil
+	}
+	if err := mealRows.Err(); err != nil {
+		return nil, err
+	}
+	mealRows.Close()
+
+	for id := range perServing {
+		recipe, err := GetRecipeByID(id)
+		if err == ErrRecipeNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if recipe.Nutrition != nil {
+			perServing[id] = &recipe.Nutrition.PerServing
+		}
+	}
+	for p := range plans {
+		for d := range plans[p].Days {
+			for m := range plans[p].Days[d].Meals {
+				meal := &plans[p].Days[d].Meals[m]
+				if info := perServing[meal.RecipeID]; info != nil {
+					meal.Nutrition = info.Scaled(meal.Portion)
+				}
+			}
+		}
+	}
+	return plans, nil
+}
+
+func DeleteMealPlan(sessionID string, id int) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM meal_plans WHERE id = ? AND session_id = ?", id, sessionID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrMealPlanNotFound
+	}
+	if _, err := tx.Exec("DELETE FROM meal_plan_meals WHERE plan_id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func encodePlanSettings(plan *config.MealPlan) (restrictions, allergies, targets string, err error) {
+	nonNil := func(list []string) []string {
+		if list == nil {
+			return []string{}
+		}
+		return list
+	}
+	var data []byte
+	if data, err = json.Marshal(nonNil(plan.Restrictions)); err != nil {
+		return
+	}
+	restrictions = string(data)
+	if data, err = json.Marshal(nonNil(plan.Allergies)); err != nil {
+		return
+	}
+	allergies = string(data)
+	if data, err = json.Marshal(plan.Targets); err != nil {
+		return
+	}
+	targets = string(data)
+	return
+}
+
+func decodePlanSettings(plan *config.MealPlan, restrictions, allergies, targets string) error {
+	if err := json.Unmarshal([]byte(restrictions), &plan.Restrictions); err != nil {
+		return fmt.Errorf("decoding meal plan restrictions failed: %w", err)
+	}
+	if err := json.Unmarshal([]byte(allergies), &plan.Allergies); err != nil {
+		return fmt.Errorf("decoding meal plan allergies failed: %w", err)
+	}
+	if err := json.Unmarshal([]byte(targets), &plan.Targets); err != nil {
+		return fmt.Errorf("decoding meal plan targets failed: %w", err)
+	}
+	return nil
+}
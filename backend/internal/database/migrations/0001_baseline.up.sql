@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS ingredients (
+    NAME TEXT PRIMARY KEY,
+    CALORIES REAL,
+    PROTEINS REAL,
+    CARBS REAL,
+    FATS REAL,
+    FIBER REAL
+);
+
+CREATE TABLE IF NOT EXISTS recipes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT,
+    vegan BOOLEAN NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS recipe_ingredients (
+    recipe_id INT,
+    ingredient_name TEXT,
+    grams
+);
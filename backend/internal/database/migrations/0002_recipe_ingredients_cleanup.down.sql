@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_recipe_ingredients_recipe;
+
+CREATE TABLE recipe_ingredients_old (
+    recipe_id INT,
+    ingredient_name TEXT,
+    grams
+);
+
+INSERT INTO recipe_ingredients_old (recipe_id, ingredient_name, grams)
+SELECT recipe_id, ingredient_name, grams FROM recipe_ingredients;
+
+DROP TABLE recipe_ingredients;
+ALTER TABLE recipe_ingredients_old RENAME TO recipe_ingredients;
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS recipe_ingredients_backup;
+
+CREATE TABLE recipe_ingredients_new (
+    recipe_id INTEGER NOT NULL REFERENCES recipes(id),
+    ingredient_name TEXT NOT NULL,
+    grams REAL NOT NULL CHECK (grams > 0)
+);
+
+INSERT INTO recipe_ingredients_new (recipe_id, ingredient_name, grams)
+SELECT recipe_id, ingredient_name, CAST(grams AS REAL)
+FROM recipe_ingredients
+WHERE recipe_id IS NOT NULL AND ingredient_name IS NOT NULL AND CAST(grams AS REAL) > 0;
+
+DROP TABLE recipe_ingredients;
+ALTER TABLE recipe_ingredients_new RENAME TO recipe_ingredients;
+
+CREATE INDEX idx_recipe_ingredients_recipe ON recipe_ingredients (recipe_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS sessions (
+    id TEXT PRIMARY KEY,
+    created_at DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS session_ingredients (
+    session_id TEXT NOT NULL,
+    name TEXT NOT NULL COLLATE NOCASE,
+    data TEXT NOT NULL,
+    added_at DATETIME NOT NULL,
+    PRIMARY KEY (session_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_last_seen ON sessions (last_seen);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS user_profiles (
+    session_id TEXT PRIMARY KEY,
+    age INTEGER NOT NULL,
+    gender TEXT NOT NULL DEFAULT '',
+    weight REAL NOT NULL,
+    height REAL NOT NULL,
+    activity_level TEXT NOT NULL DEFAULT '',
+    goal TEXT NOT NULL DEFAULT '',
+    dietary_restrictions TEXT NOT NULL DEFAULT '[]',
+    updated_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS user_profile_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id TEXT NOT NULL,
+    age INTEGER NOT NULL,
+    gender TEXT NOT NULL DEFAULT '',
+    weight REAL NOT NULL,
+    height REAL NOT NULL,
+    activity_level TEXT NOT NULL DEFAULT '',
+    goal TEXT NOT NULL DEFAULT '',
+    dietary_restrictions TEXT NOT NULL DEFAULT '[]',
+    recorded_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_profile_history_session
+    ON user_profile_history (session_id, recorded_at);
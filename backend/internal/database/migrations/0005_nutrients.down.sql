@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ingredient_nutrients;
+DROP TABLE IF EXISTS nutrients;
@@ -0,0 +1,829 @@
+CREATE TABLE nutrients (
+    key TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    unit TEXT NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO nutrients (key, name, unit, sort_order) VALUES
+    ('sugars', 'Sugars', 'g', 10),
+    ('added_sugars', 'Added sugars', 'g', 11),
+    ('saturated_fat', 'Saturated fat', 'g', 20),
+    ('trans_fat', 'Trans fat', 'g', 21),
+    ('monounsaturated_fat', 'Monounsaturated fat', 'g', 22),
+    ('polyunsaturated_fat', 'Polyunsaturated fat', 'g', 23),
+    ('cholesterol', 'Cholesterol', 'mg', 30),
+    ('sodium', 'Sodium', 'mg', 40),
+    ('potassium', 'Potassium', 'mg', 41),
+    ('calcium', 'Calcium', 'mg', 50),
+    ('iron', 'Iron', 'mg', 51),
+    ('magnesium', 'Magnesium', 'mg', 52),
+    ('zinc', 'Zinc', 'mg', 53),
+    ('vitamin_a', 'Vitamin A', 'µg', 60),
+    ('vitamin_c', 'Vitamin C', 'mg', 61),
+    ('vitamin_d', 'Vitamin D', 'µg', 62),
+    ('vitamin_e', 'Vitamin E', 'mg', 63),
+    ('vitamin_k', 'Vitamin K', 'µg', 64),
+    ('vitamin_b6', 'Vitamin B6', 'mg', 65),
+    ('vitamin_b12', 'Vitamin B12', 'µg', 66),
+    ('folate', 'Folate', 'µg', 67);
+
+CREATE TABLE ingredient_nutrients (
+    ingredient_name TEXT NOT NULL COLLATE NOCASE,
+    nutrient_key TEXT NOT NULL REFERENCES nutrients(key),
+    amount REAL NOT NULL CHECK (amount >= 0),
+    PRIMARY KEY (ingredient_name, nutrient_key)
+);
+
+-- Approximate values per 100 g for the bundled catalogue, taken from USDA
+-- FoodData Central entries for the same or comparable foods. Nutrients not
+-- listed here are left unset.
+CREATE TEMP TABLE seed_nutrients (
+    name TEXT PRIMARY KEY,
+    sugars REAL NOT NULL,
+    saturated_fat REAL NOT NULL,
+    cholesterol REAL NOT NULL,
+    sodium REAL NOT NULL,
+    potassium REAL NOT NULL,
+    calcium REAL NOT NULL,
+    iron REAL NOT NULL,
+    vitamin_c REAL NOT NULL,
+    vitamin_d REAL NOT NULL,
+    vitamin_b12 REAL NOT NULL
+);
+
+INSERT INTO seed_nutrients (name, sugars, saturated_fat, cholesterol, sodium, potassium, calcium, iron, vitamin_c, vitamin_d, vitamin_b12) VALUES
+    ('00 Flour', 0.3, 0.2, 0, 2, 107, 15, 1.2, 0, 0, 0),
+    ('Abalone', 0, 0.15, 85, 301, 250, 31, 3.2, 2, 0, 0.7),
+    ('Ackee', 0, 2.5, 0, 240, 270, 35, 0.7, 30, 0, 0),
+    ('Acorn Squash', 2.2, 0.02, 0, 3, 347, 33, 0.7, 11, 0, 0),
+    ('Adzuki Beans', 0, 0.04, 0, 8, 532, 28, 2, 0, 0, 0),
+    ('Agave Syrup', 68, 0, 0, 4, 4, 1, 0.1, 0, 0, 0),
+    ('Alfredo Sauce', 2, 33, 150, 900, 100, 180, 0.2, 0.5, 0.3, 0.4),
+    ('All-Purpose Flour', 0.3, 0.2, 0, 2, 107, 15, 4.6, 0, 0, 0),
+    ('Almond Butter', 4.4, 4.2, 0, 7, 748, 347, 3.5, 0, 0, 0),
+    ('Almond Flour', 4.4, 3.8, 0, 1, 700, 250, 3.5, 0, 0, 0),
+    ('Almond Milk', 0.1, 0.1, 0, 72, 67, 184, 0.3, 0, 1, 0),
+    ('Almonds', 4.4, 3.8, 0, 1, 733, 269, 3.7, 0, 0, 0),
+    ('Amaranth', 1.7, 1.5, 0, 4, 508, 159, 7.6, 4.2, 0, 0),
+    ('Amaranth Leaves', 0.5, 0.1, 0, 20, 611, 215, 2.3, 43, 0, 0),
+    ('Anaheim Pepper', 3, 0.02, 0, 3, 250, 12, 0.5, 100, 0, 0),
+    ('Anchovy', 0, 2.2, 60, 3668, 544, 232, 4.6, 0, 1.7, 0.9),
+    ('Andouille', 1, 9.5, 70, 1100, 280, 20, 1.2, 0, 0.5, 1),
+    ('Apple', 10.4, 0.03, 0, 1, 107, 6, 0.1, 4.6, 0, 0),
+    ('Apple Cider', 9.6, 0.01, 0, 4, 101, 8, 0.1, 0.9, 0, 0),
+    ('Apple Pie', 15, 3.8, 0, 200, 65, 11, 0.9, 1.5, 0, 0),
+    ('Apple Sauce', 14, 0.01, 0, 2, 74, 3, 0.2, 1.2, 0, 0),
+    ('Apricot', 9.2, 0.03, 0, 1, 259, 13, 0.4, 10, 0, 0),
+    ('Arborio Rice', 0.2, 0.1, 0, 1, 86, 3, 0.8, 0, 0, 0),
+    ('Arepa', 1, 0.2, 0, 300, 100, 10, 1.5, 0, 0, 0),
+    ('Arrowroot', 1.2, 0.04, 0, 26, 454, 6, 2.2, 1.9, 0, 0),
+    ('Arrowroot Flour', 0, 0.02, 0, 2, 11, 40, 0.3, 0, 0, 0),
+    ('Artichoke', 1, 0.04, 0, 94, 370, 44, 1.3, 11.7, 0, 0),
+    ('Arugula', 2.1, 0.09, 0, 27, 369, 160, 1.5, 15, 0, 0),
+    ('Ash Gourd', 0.3, 0.02, 0, 111, 6, 19, 0.4, 13, 0, 0),
+    ('Asparagus', 1.9, 0.04, 0, 2, 202, 24, 2.1, 5.6, 0, 0),
+    ('Aspartame', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Avocado', 0.7, 2.1, 0, 7, 485, 12, 0.6, 10, 0, 0),
+    ('Avocado Oil', 0, 11.6, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Açaí', 0, 1.2, 0, 5, 100, 35, 0.6, 1, 0, 0),
+    ('Bacaba', 2, 1.5, 0, 5, 200, 40, 1, 2, 0, 0),
+    ('Bacon', 1, 14, 110, 1700, 500, 11, 1.4, 0, 0.3, 1.2),
+    ('Bagel', 5, 0.3, 0, 430, 100, 20, 3.2, 0, 0, 0),
+    ('Baguette', 3, 0.1, 0, 600, 115, 20, 3, 0, 0, 0),
+    ('Baking Powder', 0, 0, 0, 10600, 20, 5900, 11, 0, 0, 0),
+    ('Baking Soda', 0, 0, 0, 27360, 0, 0, 0, 0, 0, 0),
+    ('Baklava', 25, 7, 20, 300, 180, 35, 1.7, 0.5, 0, 0.1),
+    ('Bamboo Shoots', 3, 0.07, 0, 4, 533, 13, 0.5, 4, 0, 0),
+    ('Banana', 12.2, 0.11, 0, 1, 358, 5, 0.3, 8.7, 0, 0),
+    ('Baobab Fruit', 22, 0.1, 0, 7, 2300, 300, 8, 210, 0, 0),
+    ('Barbecue Sauce', 23, 0.03, 0, 800, 230, 30, 0.6, 0.6, 0, 0),
+    ('Barberry', 7, 0.3, 0, 8, 150, 40, 2.6, 30, 0, 0),
+    ('Barley', 0.8, 0.5, 0, 12, 452, 33, 3.6, 0, 0, 0),
+    ('Barley Flour', 0.8, 0.3, 0, 4, 309, 32, 2.7, 0, 0, 0),
+    ('Basil', 0.3, 0.04, 0, 4, 295, 177, 3.2, 18, 0, 0),
+    ('Basmati Rice', 0.1, 0.2, 0, 1, 100, 10, 0.8, 0, 0, 0),
+    ('Beech Nut', 1, 5.7, 0, 38, 1017, 1, 2.5, 15.5, 0, 0),
+    ('Beef (lean)', 0, 6, 80, 60, 320, 12, 2.6, 0, 0.1, 2.6),
+    ('Beef Brisket', 0, 2.5, 62, 70, 330, 7, 2, 0, 0.1, 2.5),
+    ('Beef Broth', 0.1, 0.1, 0, 370, 60, 5, 0.2, 0, 0, 0),
+    ('Beef Chuck', 0, 6.5, 70, 65, 290, 10, 2.2, 0, 0.1, 2.6),
+    ('Beef Jerky', 9, 11, 48, 1780, 600, 20, 5.4, 0, 0.2, 1),
+    ('Beef Kidney', 0, 1, 410, 180, 260, 13, 4.6, 9.4, 1.1, 28),
+    ('Beef Liver', 0, 1.3, 275, 69, 313, 5, 4.9, 1.3, 1.2, 59),
+    ('Beef Ribeye', 0, 8.5, 80, 55, 290, 10, 2, 0, 0.1, 2.2),
+    ('Beef Sirloin', 0, 4, 75, 55, 340, 12, 1.7, 0, 0.1, 1.6),
+    ('Beef Stock', 0.3, 0.2, 0, 300, 180, 5, 0.3, 0, 0, 0.1),
+    ('Beef Tallow', 0, 50, 109, 0, 0, 0, 0, 0, 0, 0),
+    ('Beef Tenderloin', 0, 3.6, 71, 52, 340, 10, 1.9, 0, 0.1, 1.9),
+    ('Beef Tongue', 0, 8, 132, 69, 184, 5, 2.6, 1.3, 0.1, 3.1),
+    ('Beet Greens', 0.5, 0.02, 0, 226, 762, 117, 2.6, 30, 0, 0),
+    ('Beetroot', 6.8, 0.03, 0, 78, 325, 16, 0.8, 4.9, 0, 0),
+    ('Bell Pepper', 4.2, 0.03, 0, 4, 211, 7, 0.4, 128, 0, 0),
+    ('Beluga Lentils', 1.8, 0.05, 0, 2, 369, 19, 3.3, 1.5, 0, 0),
+    ('Bilberry', 10, 0.03, 0, 1, 77, 6, 0.3, 9.7, 0, 0),
+    ('Biscuit', 20, 7, 20, 400, 110, 30, 1.8, 0, 0, 0),
+    ('Bison', 0, 1.4, 82, 57, 350, 8, 3.2, 0, 0, 2.9),
+    ('Bitter Melon', 1, 0.01, 0, 5, 296, 19, 0.4, 84, 0, 0),
+    ('Black Beans', 0.3, 0.1, 0, 1, 355, 27, 2.1, 0, 0, 0),
+    ('Black Pepper', 0.6, 1.4, 0, 20, 1329, 443, 9.7, 0, 0, 0),
+    ('Black Rice', 0.5, 0.6, 0, 4, 268, 15, 2.4, 0, 0, 0),
+    ('Black Sapote', 20, 0.1, 0, 7, 200, 22, 0.4, 190, 0, 0),
+    ('Black-eyed Peas', 3, 0.1, 0, 4, 278, 24, 1.2, 0.4, 0, 0),
+    ('Blended Oil', 0, 10, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Blood Orange', 9, 0.02, 0, 0, 181, 40, 0.1, 53, 0, 0),
+    ('Blood Sausage', 1, 13.4, 120, 680, 38, 6, 6.4, 0, 0, 1),
+    ('Blue Cheese', 0.5, 18.7, 75, 1146, 256, 528, 0.3, 0, 0.5, 1.2),
+    ('Blueberry', 10, 0.03, 0, 1, 77, 6, 0.3, 9.7, 0, 0),
+    ('Bockwurst', 0.5, 9.5, 60, 1000, 250, 25, 1, 0, 0.3, 1),
+    ('Bok Choy', 1.2, 0.03, 0, 65, 252, 105, 0.8, 45, 0, 0),
+    ('Bone Broth', 0, 0.2, 5, 300, 100, 10, 0.3, 0, 0, 0.1),
+    ('Borlotti Beans', 0.3, 0.1, 0, 1, 508, 50, 2.4, 0.8, 0, 0),
+    ('Bottle Gourd', 2, 0.01, 0, 2, 150, 26, 0.2, 10, 0, 0),
+    ('Boysenberry', 6.9, 0.02, 0, 1, 139, 27, 0.9, 3.1, 0, 0),
+    ('Bratwurst', 2, 10, 74, 850, 220, 16, 1, 0, 0.3, 0.9),
+    ('Brazil Nut', 2.3, 15, 0, 3, 659, 160, 2.4, 0.7, 0, 0),
+    ('Bread Flour', 0.3, 0.2, 0, 2, 100, 15, 4.4, 0, 0, 0),
+    ('Breadcrumbs', 6, 1.1, 0, 730, 200, 180, 4.8, 0, 0, 0),
+    ('Breadfruit', 11, 0.05, 0, 2, 490, 17, 0.5, 29, 0, 0),
+    ('Breadnut', 2, 1.5, 0, 40, 1000, 80, 2, 2, 0, 0),
+    ('Breadstick', 2, 1.3, 0, 650, 125, 22, 4.3, 0, 0, 0),
+    ('Bresaola', 0, 0.8, 60, 1900, 500, 15, 2.5, 0, 0, 1.5),
+    ('Brie Cheese', 0.5, 17.4, 100, 629, 152, 184, 0.5, 0, 0.5, 1.7),
+    ('Brioche', 10, 5.5, 80, 400, 120, 40, 2, 0, 0.5, 0.2),
+    ('Broccoli', 1.7, 0.04, 0, 33, 316, 47, 0.7, 89, 0, 0),
+    ('Broccolini', 1.5, 0.05, 0, 30, 300, 50, 0.8, 80, 0, 0),
+    ('Brown Rice', 0.2, 0.2, 0, 4, 43, 3, 0.4, 0, 0, 0),
+    ('Brown Sugar', 97, 0, 0, 28, 133, 83, 0.7, 0, 0, 0),
+    ('Brownie', 36, 7, 50, 250, 180, 30, 2.3, 0, 0.2, 0.1),
+    ('Brussels Sprouts', 2.2, 0.06, 0, 25, 389, 42, 1.4, 85, 0, 0),
+    ('Buckwheat', 0, 0.7, 0, 1, 460, 18, 2.2, 0, 0, 0),
+    ('Buckwheat Flour', 2.6, 0.7, 0, 11, 577, 41, 4.1, 0, 0, 0),
+    ('Bulgur', 0.4, 0.2, 0, 17, 410, 35, 2.5, 0, 0, 0),
+    ('Burdock Root', 2.9, 0.03, 0, 5, 308, 41, 0.8, 3, 0, 0),
+    ('Butter', 0.1, 51, 215, 11, 24, 24, 0, 0, 1.5, 0.2),
+    ('Buttermilk', 4.8, 0.5, 4, 105, 151, 116, 0, 1, 0, 0.2),
+    ('Butternut Squash', 2.2, 0.02, 0, 4, 352, 48, 0.7, 21, 0, 0),
+    ('Butterscotch', 80, 2.5, 10, 200, 20, 15, 0.1, 0, 0, 0),
+    ('Cabbage', 3.2, 0.03, 0, 18, 170, 40, 0.5, 36.6, 0, 0),
+    ('Cake Flour', 0.3, 0.1, 0, 2, 105, 14, 7.3, 0, 0, 0),
+    ('Calamondin', 2, 0.01, 0, 3, 186, 30, 0.5, 33, 0, 0),
+    ('Camelina Oil', 0, 9, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Camembert Cheese', 0.5, 15.3, 72, 842, 187, 388, 0.3, 0, 0.4, 1.3),
+    ('Camu Camu', 2, 0.01, 0, 11, 84, 16, 0.5, 2000, 0, 0),
+    ('Candied Fruit', 75, 0.02, 0, 100, 50, 20, 0.4, 0, 0, 0),
+    ('Candy Cane', 70, 0, 0, 20, 3, 3, 0.1, 0, 0, 0),
+    ('Canistel', 15, 0.02, 0, 12, 300, 26, 0.9, 58, 0, 0),
+    ('Cannellini Beans', 0.3, 0.15, 0, 6, 561, 90, 3.7, 0, 0, 0),
+    ('Canola Oil', 0, 7.4, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Carambola', 4, 0.02, 0, 2, 133, 3, 0.1, 34, 0, 0),
+    ('Caramel', 65, 1.7, 7, 245, 214, 140, 0.1, 0, 0, 0.1),
+    ('Carrot', 4.7, 0.04, 0, 69, 320, 33, 0.3, 5.9, 0, 0),
+    ('Carrot Cake', 35, 4, 40, 350, 130, 30, 1, 1, 0.3, 0.1),
+    ('Cashew Butter', 5, 9.8, 0, 15, 546, 43, 5, 0, 0, 0),
+    ('Cashews', 5.9, 7.8, 0, 12, 660, 37, 6.7, 0.5, 0, 0),
+    ('Cassava', 1.7, 0.07, 0, 14, 271, 16, 0.3, 20.6, 0, 0),
+    ('Catfish', 0, 0.8, 58, 43, 358, 14, 0.3, 0.7, 12.5, 2.2),
+    ('Cauliflower', 1.9, 0.13, 0, 30, 299, 22, 0.4, 48, 0, 0),
+    ('Caviar', 0, 4.1, 588, 1500, 181, 275, 11.9, 0, 2.9, 20),
+    ('Celeriac', 1.6, 0.08, 0, 100, 300, 43, 0.7, 8, 0, 0),
+    ('Celery', 1.3, 0.04, 0, 80, 260, 40, 0.2, 3.1, 0, 0),
+    ('Chapati', 2, 1.5, 0, 400, 200, 30, 3, 0, 0, 0),
+    ('Chayote', 1.7, 0.03, 0, 2, 125, 17, 0.3, 7.7, 0, 0),
+    ('Chayote Squash', 1.7, 0.03, 0, 2, 125, 17, 0.3, 7.7, 0, 0),
+    ('Cheddar Cheese', 0.5, 19, 99, 653, 76, 710, 0.1, 0, 0.6, 1.1),
+    ('Cheesecake', 21, 9.9, 55, 440, 90, 51, 0.6, 0.4, 0.5, 0.2),
+    ('Cherimoya', 13, 0.2, 0, 7, 287, 10, 0.3, 12.6, 0, 0),
+    ('Cherry', 8.5, 0.04, 0, 0, 222, 13, 0.4, 7, 0, 0),
+    ('Chestnut', 11, 0.2, 0, 2, 484, 19, 0.9, 26, 0, 0),
+    ('Chestnut Flour', 20, 0.7, 0, 10, 850, 50, 2.4, 0, 0, 0),
+    ('Chewing Gum', 66, 0, 0, 1, 2, 3, 0, 0, 0, 0),
+    ('Chia Seeds', 0, 3.3, 0, 16, 407, 631, 7.7, 1.6, 0, 0),
+    ('Chicken Breast', 0, 1, 85, 74, 256, 15, 1, 0, 0.1, 0.3),
+    ('Chicken Broth', 0.2, 0.1, 1, 370, 40, 4, 0.1, 0, 0, 0),
+    ('Chicken Drumstick', 0, 2.7, 92, 95, 230, 11, 0.8, 0, 0.1, 0.4),
+    ('Chicken Fat', 0, 30, 85, 0, 0, 0, 0, 0, 0, 0),
+    ('Chicken Gizzard', 0, 0.5, 370, 69, 237, 11, 2.5, 3.7, 0, 1),
+    ('Chicken Heart', 0, 1.7, 242, 48, 176, 19, 9, 1, 0, 7.3),
+    ('Chicken Liver', 0, 1.6, 345, 71, 230, 8, 9, 18, 0, 16.6),
+    ('Chicken Nugget', 0.5, 3.5, 45, 550, 280, 15, 1, 0, 0.1, 0.3),
+    ('Chicken Patty', 1, 3.5, 40, 500, 250, 15, 1.2, 0, 0.1, 0.3),
+    ('Chicken Stock', 0.3, 0.2, 3, 340, 120, 5, 0.2, 0, 0, 0),
+    ('Chicken Thigh', 0, 4.2, 93, 90, 220, 9, 0.9, 0, 0.1, 0.4),
+    ('Chicken Wing', 0, 3.9, 80, 80, 190, 12, 0.9, 0, 0.1, 0.3),
+    ('Chickpea Flour', 10.9, 0.7, 0, 64, 846, 45, 4.9, 0, 0, 0),
+    ('Chickpeas', 4.8, 0.3, 0, 7, 291, 49, 2.9, 1.3, 0, 0),
+    ('Chicory', 0.7, 0.07, 0, 45, 420, 100, 0.9, 24, 0, 0),
+    ('Chinese Cabbage', 1.2, 0.04, 0, 9, 238, 77, 0.3, 27, 0, 0),
+    ('Chives', 1.9, 0.15, 0, 3, 296, 92, 1.6, 58, 0, 0),
+    ('Chocolate Bar', 48, 18.5, 8, 24, 559, 56, 8, 0, 0, 0.2),
+    ('Chocolate Chip Cookie', 35, 8, 25, 350, 150, 25, 2.5, 0, 0, 0.1),
+    ('Chocolate Eclair', 25, 7, 120, 300, 115, 40, 1, 0, 0.5, 0.3),
+    ('Chocolate Fudge', 65, 6.5, 10, 45, 134, 40, 1.3, 0, 0, 0.1),
+    ('Chocolate Spread', 56, 10, 0, 40, 400, 110, 2.4, 0, 0, 0),
+    ('Chocolate Truffle', 40, 23, 30, 20, 400, 50, 3, 0, 0, 0.1),
+    ('Chokeberry', 4.5, 0.01, 0, 2, 218, 32, 0.9, 21, 0, 0),
+    ('Chorizo', 1, 14.4, 88, 1240, 398, 10, 1.6, 0, 0.5, 2),
+    ('Ciabatta', 1.5, 0.5, 0, 500, 120, 20, 2.7, 0, 0, 0),
+    ('Cinnamon', 2.2, 0.3, 0, 10, 431, 1002, 8.3, 3.8, 0, 0),
+    ('Clam', 0, 0.2, 30, 601, 46, 46, 1.6, 0, 0, 11.3),
+    ('Clarified Butter', 0, 62, 256, 2, 5, 4, 0, 0, 1.5, 0),
+    ('Clementine', 9.2, 0.02, 0, 1, 177, 30, 0.1, 48.8, 0, 0),
+    ('Clotted Cream', 2, 39, 170, 20, 70, 55, 0.1, 0, 0.8, 0.2),
+    ('Cloudberry', 6, 0.02, 0, 1, 180, 15, 0.7, 158, 0, 0),
+    ('Cockle', 0, 0.2, 50, 300, 250, 130, 24, 0, 0, 40),
+    ('Cocoa Powder', 1.8, 8.1, 0, 21, 1524, 128, 13.9, 0, 0, 0),
+    ('Coconut Flour', 8, 11, 0, 60, 1000, 30, 6, 0, 0, 0),
+    ('Coconut Milk', 3.3, 21, 0, 15, 263, 16, 1.6, 2.8, 0, 0),
+    ('Coconut Oil', 0, 82.5, 0, 0, 0, 1, 0, 0, 0, 0),
+    ('Coconut Sugar', 75, 0, 0, 45, 1030, 8, 0.3, 0, 0, 0),
+    ('Cod', 0, 0.13, 43, 54, 413, 16, 0.4, 1, 0.9, 0.9),
+    ('Cod Liver Oil', 0, 22.6, 570, 0, 0, 0, 0, 0, 250, 0),
+    ('Coffee', 0, 0, 0, 2, 49, 2, 0, 0, 0, 0),
+    ('Collard Greens', 0.5, 0.06, 0, 17, 213, 232, 0.5, 35, 0, 0),
+    ('Condensed Milk', 54.4, 5.5, 34, 127, 371, 284, 0.2, 2.6, 0.2, 0.4),
+    ('Coriander', 0.9, 0.01, 0, 46, 521, 67, 1.8, 27, 0, 0),
+    ('Corn', 6.3, 0.3, 0, 15, 270, 2, 0.5, 6.8, 0, 0),
+    ('Corn Bran', 0.6, 0.3, 0, 7, 44, 42, 2.8, 0, 0, 0),
+    ('Corn Flakes', 9.5, 0.1, 0, 730, 100, 5, 28, 0, 2.4, 2),
+    ('Corn Oil', 0, 13, 0, 0, 0, 1, 0, 0, 0, 0),
+    ('Corn Syrup', 30, 0, 0, 62, 1, 3, 0, 0, 0, 0),
+    ('Corn Tortilla', 0.9, 0.4, 0, 45, 186, 81, 1.2, 0, 0, 0),
+    ('Cornbread', 13, 3.5, 60, 600, 150, 250, 2, 0.3, 0.3, 0.2),
+    ('Corned Beef', 0, 6, 98, 970, 145, 8, 1.9, 0, 0.2, 1.6),
+    ('Cornish Hen', 0, 3.7, 110, 65, 245, 10, 0.8, 0.5, 0, 0.3),
+    ('Cornmeal', 0.6, 0.3, 0, 35, 287, 6, 3.5, 0, 0, 0),
+    ('Cornstarch', 0, 0.01, 0, 9, 3, 2, 0.5, 0, 0, 0),
+    ('Cottage Cheese', 2.7, 1.7, 17, 364, 104, 83, 0.1, 0, 0.1, 0.4),
+    ('Cottonseed Oil', 0, 26, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Couscous', 0.1, 0.03, 0, 5, 58, 8, 0.4, 0, 0, 0),
+    ('Crab', 0, 0.2, 97, 293, 329, 89, 0.7, 7.6, 0, 9),
+    ('Cracker', 6, 3.5, 0, 850, 150, 80, 4, 0, 0, 0),
+    ('Cranberry', 4, 0.01, 0, 2, 80, 8, 0.2, 14, 0, 0),
+    ('Cranberry Sauce', 31, 0.01, 0, 29, 26, 4, 0.2, 2, 0, 0),
+    ('Cream Cheese', 3.2, 20, 101, 314, 132, 97, 0.1, 0, 0.6, 0.2),
+    ('Cream of Wheat', 0.1, 0, 0, 60, 20, 100, 5, 0, 0, 0),
+    ('Cress', 4.4, 0.02, 0, 14, 606, 81, 1.3, 69, 0, 0),
+    ('Croissant', 11, 11.7, 67, 467, 118, 37, 2, 0.2, 0.2, 0.2),
+    ('Crumpet', 2, 0.2, 0, 500, 100, 80, 1.5, 0, 0, 0),
+    ('Crème Fraîche', 2.5, 24, 110, 30, 90, 80, 0.1, 0, 0.4, 0.2),
+    ('Cucumber', 1.7, 0.04, 0, 2, 147, 16, 0.3, 2.8, 0, 0),
+    ('Cupuassu', 6, 0.3, 0, 5, 300, 20, 0.5, 30, 0, 0),
+    ('Cupuaçu', 6, 0.3, 0, 5, 300, 20, 0.5, 30, 0, 0),
+    ('Currant', 7.4, 0.02, 0, 1, 275, 33, 1, 41, 0, 0),
+    ('Curry Paste', 6, 0.5, 0, 1500, 300, 60, 3, 2, 0, 0),
+    ('Cuttlefish', 0, 0.1, 112, 372, 354, 90, 6, 5, 0, 3),
+    ('Daikon', 2.5, 0.03, 0, 21, 227, 27, 0.4, 22, 0, 0),
+    ('Daikon Radish', 2.5, 0.03, 0, 21, 227, 27, 0.4, 22, 0, 0),
+    ('Damson', 9.9, 0.02, 0, 0, 157, 6, 0.2, 9.5, 0, 0),
+    ('Dandelion Greens', 0.7, 0.2, 0, 76, 397, 187, 3.1, 35, 0, 0),
+    ('Danish Pastry', 22, 9, 25, 350, 110, 45, 2, 0, 0.2, 0.2),
+    ('Dark Chocolate', 24, 19, 3, 20, 715, 73, 11.9, 0, 0, 0.3),
+    ('Dashi', 0, 0, 0, 350, 40, 3, 0.1, 0, 0, 0.2),
+    ('Date', 63, 0, 0, 2, 656, 39, 1, 0.4, 0, 0),
+    ('Date Syrup', 65, 0, 0, 10, 700, 60, 1, 0, 0, 0),
+    ('Delicata Squash', 3, 0.02, 0, 4, 300, 30, 0.5, 15, 0, 0),
+    ('Dill', 0, 0.06, 0, 61, 738, 208, 6.6, 85, 0, 0),
+    ('Dill Weed', 0, 0.06, 0, 61, 738, 208, 6.6, 85, 0, 0),
+    ('Dragon Fruit', 8, 0.03, 0, 0, 228, 18, 0.7, 3, 0, 0),
+    ('Duck', 0, 9.7, 76, 63, 204, 11, 2.7, 2.8, 0.1, 0.3),
+    ('Duck Breast', 0, 2.3, 143, 105, 252, 12, 4.5, 0, 0.1, 0.4),
+    ('Duck Confit', 0, 12, 100, 1000, 200, 10, 2.5, 0, 0.1, 0.3),
+    ('Duck Drippings', 0, 33, 100, 0, 0, 0, 0, 0, 0, 0),
+    ('Duck Fat', 0, 33, 100, 0, 0, 0, 0, 0, 0, 0),
+    ('Duck Leg', 0, 3.5, 110, 100, 250, 12, 2.3, 0, 0.1, 0.4),
+    ('Duck Liver', 0, 1.8, 515, 140, 230, 11, 30.5, 4.5, 0, 54),
+    ('Durum Wheat Flour', 0.4, 0.5, 0, 2, 431, 34, 3.5, 0, 0, 0),
+    ('Edamame', 2.2, 0.6, 0, 6, 436, 63, 2.3, 6.1, 0, 0),
+    ('Eel', 0, 2.3, 126, 51, 272, 20, 0.5, 1.8, 23, 3),
+    ('Egg', 1.1, 3.3, 373, 124, 126, 56, 1.8, 0, 2, 0.9),
+    ('Egg Noodles', 0.4, 0.3, 29, 5, 38, 12, 1.5, 0, 0, 0.1),
+    ('Eggplant', 3.5, 0.03, 0, 2, 229, 9, 0.2, 2.2, 0, 0),
+    ('Einkorn', 1, 0.4, 0, 2, 400, 30, 4.5, 0, 0, 0),
+    ('Elderberry', 0, 0.02, 0, 6, 280, 38, 1.6, 36, 0, 0),
+    ('Elk', 0, 0.7, 55, 50, 330, 4, 3.3, 0, 0, 2.5),
+    ('Emmental Cheese', 0, 18, 93, 200, 100, 1000, 0.2, 0, 0.4, 3),
+    ('Emmer', 1, 0.4, 0, 5, 380, 30, 4, 0, 0, 0),
+    ('Endive', 0.3, 0.05, 0, 22, 314, 52, 0.8, 6.5, 0, 0),
+    ('English Muffin', 3.5, 0.2, 0, 420, 120, 170, 2.6, 0, 0, 0),
+    ('Erythritol', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Escarole', 0.3, 0.05, 0, 22, 314, 52, 0.8, 6.5, 0, 0),
+    ('Evaporated Milk', 10, 4.6, 29, 106, 303, 261, 0.2, 1.9, 2, 0.2),
+    ('Farina', 0.3, 0.1, 0, 3, 100, 20, 5, 0, 0, 0),
+    ('Farro', 1, 0.4, 0, 5, 380, 30, 4, 0, 0, 0),
+    ('Fava Beans', 1.8, 0.1, 0, 5, 250, 37, 1.5, 0.3, 0, 0),
+    ('Feijoa', 8.2, 0.2, 0, 3, 172, 17, 0.1, 32.9, 0, 0),
+    ('Fennel', 3.9, 0.09, 0, 52, 414, 49, 0.7, 12, 0, 0),
+    ('Feta Cheese', 4.1, 14.9, 89, 917, 62, 493, 0.7, 0, 0.4, 1.7),
+    ('Fiddlehead Fern', 0, 0.1, 0, 1, 370, 32, 1.3, 26.6, 0, 0),
+    ('Fig', 16.3, 0.06, 0, 1, 232, 35, 0.4, 2, 0, 0),
+    ('Fish Oil', 0, 21, 521, 0, 0, 0, 0, 0, 0, 0),
+    ('Fish Stock', 0, 0.1, 0, 360, 140, 5, 0.1, 0, 0, 0.2),
+    ('Flatbread', 2, 0.4, 0, 500, 120, 40, 3, 0, 0, 0),
+    ('Flax Seeds', 1.6, 3.7, 0, 30, 813, 255, 5.7, 0.6, 0, 0),
+    ('Flaxseed Oil', 0, 9, 0, 0, 0, 1, 0, 0, 0, 0),
+    ('Flounder', 0, 0.4, 45, 296, 160, 21, 0.2, 0, 2.8, 1.1),
+    ('Flour Tortilla', 3.5, 2.8, 0, 600, 170, 140, 3.3, 0, 0, 0),
+    ('Focaccia', 2, 1.2, 0, 570, 120, 20, 3, 0, 0, 0),
+    ('Fondant', 88, 0, 0, 10, 2, 2, 0, 0, 0, 0),
+    ('Fonio', 0.5, 0.2, 0, 5, 150, 40, 2.4, 0, 0, 0),
+    ('Frankfurter', 1.5, 10, 77, 1090, 204, 11, 1.2, 0, 0.5, 1.3),
+    ('Freekeh', 0.4, 0.4, 0, 2, 500, 40, 4.5, 0, 0, 0),
+    ('French Lentils', 1.8, 0.05, 0, 2, 369, 19, 3.3, 1.5, 0, 0),
+    ('Fruit Chews', 60, 0, 0, 20, 5, 5, 0.1, 0, 0, 0),
+    ('Fruit Drops', 65, 0, 0, 20, 3, 3, 0.1, 0, 0, 0),
+    ('Fruit Gums', 55, 0, 0, 30, 5, 5, 0.1, 0, 0, 0),
+    ('Fruit Jam', 48.5, 0.01, 0, 32, 77, 20, 0.5, 8.8, 0, 0),
+    ('Fruit Jelly', 51, 0.01, 0, 30, 54, 7, 0.2, 0.8, 0, 0),
+    ('Fruit Leather', 52, 0.1, 0, 300, 300, 30, 1, 0, 0, 0),
+    ('Fruit Pastilles', 60, 0, 0, 30, 10, 5, 0.1, 0, 0, 0),
+    ('Fudge', 65, 6.5, 10, 45, 134, 40, 1.3, 0, 0, 0.1),
+    ('Game Hen', 0, 3.9, 110, 70, 250, 12, 0.9, 0, 0, 0.3),
+    ('Garlic', 1, 0.09, 0, 17, 401, 181, 1.7, 31.2, 0, 0),
+    ('Gelatin', 0, 0, 0, 196, 16, 55, 1.1, 0, 0, 0),
+    ('Ghee', 0, 62, 256, 2, 5, 4, 0, 0, 1.5, 0),
+    ('Ghost Pepper', 5.3, 0.04, 0, 9, 322, 14, 1, 144, 0, 0),
+    ('Ginger', 1.7, 0.2, 0, 13, 415, 16, 0.6, 5, 0, 0),
+    ('Gingerbread', 35, 2, 30, 430, 300, 90, 3, 0, 0, 0.1),
+    ('Ginkgo Nut', 0, 0.3, 0, 7, 510, 2, 1, 15, 0, 0),
+    ('Glass Noodles', 0, 0, 0, 10, 10, 25, 2.2, 0, 0, 0),
+    ('Gluten-Free Flour', 1, 0.4, 0, 5, 150, 15, 1, 0, 0, 0),
+    ('Goat Cheese', 2, 20.6, 79, 415, 158, 140, 1.6, 0, 0.5, 0.2),
+    ('Goat Meat', 0, 0.9, 57, 82, 385, 13, 2.8, 0, 0, 1.1),
+    ('Goat Milk', 4.5, 2.7, 11, 50, 204, 134, 0.1, 1.3, 0.1, 0.1),
+    ('Goji Berry', 45.6, 0, 0, 298, 1132, 190, 6.8, 48.4, 0, 0),
+    ('Golden Syrup', 79, 0, 0, 300, 50, 10, 1, 0, 0, 0),
+    ('Goldenberry', 7, 0.1, 0, 0, 200, 9, 1, 11, 0, 0),
+    ('Goose', 0, 7, 80, 73, 308, 12, 2.5, 0, 0, 0.4),
+    ('Goose Fat', 0, 28, 100, 0, 0, 0, 0, 0, 0, 0),
+    ('Gooseberry', 6, 0.04, 0, 1, 198, 25, 0.3, 27.7, 0, 0),
+    ('Gouda Cheese', 2.2, 17.6, 114, 819, 121, 700, 0.2, 0, 0.5, 1.5),
+    ('Granola', 24, 4, 0, 200, 400, 60, 3.5, 1, 0, 0),
+    ('Grapes', 15.5, 0.05, 0, 2, 191, 10, 0.4, 3.2, 0, 0),
+    ('Grapeseed Oil', 0, 9.6, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Greek Yogurt', 3.2, 0.1, 5, 36, 141, 110, 0.1, 0, 0, 0.8),
+    ('Green Beans', 3.3, 0.03, 0, 6, 211, 37, 1, 12.2, 0, 0),
+    ('Green Lentils', 1.8, 0.05, 0, 2, 369, 19, 3.3, 1.5, 0, 0),
+    ('Green Onion', 2.3, 0.03, 0, 16, 276, 72, 1.5, 18.8, 0, 0),
+    ('Green Peas', 5.7, 0.07, 0, 5, 244, 25, 1.5, 40, 0, 0),
+    ('Greengage', 9.9, 0.02, 0, 0, 157, 6, 0.2, 9.5, 0, 0),
+    ('Grits', 0.6, 0.2, 0, 1, 140, 3, 4, 0, 0, 0),
+    ('Grouper', 0, 0.3, 47, 53, 475, 21, 1.1, 0, 0, 0.7),
+    ('Guava', 8.9, 0.3, 0, 2, 417, 18, 0.3, 228, 0, 0),
+    ('Guinea Fowl', 0, 1.5, 63, 69, 220, 11, 0.8, 1.7, 0, 0.4),
+    ('Gummy Bears', 46, 0, 0, 40, 5, 5, 0.3, 0, 0, 0),
+    ('Habanero Pepper', 5.3, 0.04, 0, 9, 322, 14, 1, 144, 0, 0),
+    ('Haddock', 0, 0.1, 66, 213, 351, 14, 0.2, 0, 0.6, 1.8),
+    ('Halibut', 0, 0.4, 41, 68, 528, 8, 0.2, 0, 4.7, 1.1),
+    ('Halloumi', 2, 17, 80, 2600, 100, 700, 0.2, 0, 0.4, 1),
+    ('Halva', 45, 5, 0, 195, 200, 60, 1.8, 0, 0, 0),
+    ('Hard Candy', 63, 0, 0, 38, 5, 3, 0.3, 0, 0, 0),
+    ('Hawthorn Berry', 6, 0.01, 0, 1, 200, 20, 0.5, 14, 0, 0),
+    ('Hazelnut Oil', 0, 7.4, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Hazelnut Spread', 56, 10, 0, 40, 400, 110, 2.4, 0, 0, 0),
+    ('Hazelnuts', 4.3, 4.5, 0, 0, 680, 114, 4.7, 6.3, 0, 0),
+    ('Heavy Cream', 2.9, 23, 113, 27, 95, 66, 0.1, 0.6, 1, 0.2),
+    ('Hemp Oil', 0, 9, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Herring', 0, 2, 60, 90, 327, 57, 1.1, 0.7, 4.2, 13.7),
+    ('Hickory Nut', 2, 7, 0, 1, 436, 61, 2.1, 2, 0, 0),
+    ('Hominy', 0.2, 0.2, 0, 345, 9, 10, 0.6, 0, 0, 0),
+    ('Honey', 82, 0, 0, 4, 52, 6, 0.4, 0.5, 0, 0),
+    ('Honeycomb', 80, 0, 0, 5, 50, 5, 0.4, 0.5, 0, 0),
+    ('Horse Gram', 1, 0.1, 0, 10, 760, 287, 6.8, 0, 0, 0),
+    ('Horse Meat', 0, 1.5, 52, 53, 360, 6, 3.8, 1, 0, 3),
+    ('Horseradish', 8, 0.1, 0, 314, 246, 56, 0.4, 24.9, 0, 0),
+    ('Hot Sauce', 2, 0.1, 0, 2640, 140, 8, 0.5, 75, 0, 0),
+    ('Huckleberry', 7, 0.02, 0, 10, 70, 15, 0.3, 3, 0, 0),
+    ('Hummus', 0.3, 1.4, 0, 379, 228, 38, 2.4, 0, 0, 0),
+    ('Icing Sugar', 97.8, 0, 0, 2, 2, 1, 0.1, 0, 0, 0),
+    ('Injera', 0.5, 0.1, 0, 20, 150, 40, 3, 0, 0, 0),
+    ('Ivy Gourd', 1, 0.02, 0, 2, 150, 40, 1.4, 15, 0, 0),
+    ('Jabuticaba', 8, 0.02, 0, 1, 130, 10, 0.5, 23, 0, 0),
+    ('Jackfruit', 19, 0.2, 0, 2, 448, 24, 0.2, 13.7, 0, 0),
+    ('Jalapeno', 4.1, 0.09, 0, 3, 248, 12, 0.3, 118, 0, 0),
+    ('Jasmine Rice', 0.1, 0.2, 0, 5, 86, 10, 0.8, 0, 0, 0),
+    ('Jelly Beans', 70, 0, 0, 50, 37, 3, 0.1, 0, 0, 0),
+    ('Jerusalem Artichoke', 9.6, 0, 0, 4, 429, 14, 3.4, 4, 0, 0),
+    ('Jicama', 1.8, 0.02, 0, 4, 150, 12, 0.6, 20.2, 0, 0),
+    ('Kabocha Squash', 3, 0.03, 0, 1, 340, 20, 0.6, 15, 0, 0),
+    ('Kale', 2.3, 0.09, 0, 38, 491, 150, 1.5, 120, 0, 0),
+    ('Kamut', 8, 0.2, 0, 6, 446, 24, 4.4, 0, 0, 0),
+    ('Kamut Flour', 8, 0.2, 0, 6, 446, 24, 4.4, 0, 0, 0),
+    ('Kangaroo', 0, 0.4, 56, 50, 330, 5, 3.2, 0, 0, 2.3),
+    ('Kefir', 4.6, 0.6, 5, 40, 164, 130, 0, 0.2, 0.1, 0.3),
+    ('Ketchup', 22.8, 0.03, 0, 907, 281, 15, 0.4, 4.1, 0, 0),
+    ('Kidney Beans', 0.3, 0.07, 0, 1, 405, 28, 2.9, 1.2, 0, 0),
+    ('Kiwi', 9, 0.03, 0, 3, 312, 34, 0.3, 92.7, 0, 0),
+    ('Knackwurst', 2, 10, 60, 930, 200, 11, 0.9, 0, 0.4, 1.2),
+    ('Kohlrabi', 2.6, 0.01, 0, 20, 350, 24, 0.4, 62, 0, 0),
+    ('Komatsuna', 0.5, 0.02, 0, 15, 450, 210, 2.8, 39, 0, 0),
+    ('Lamb Chop', 0, 9, 97, 77, 320, 17, 1.9, 0, 0.1, 2.6),
+    ('Lamb Leg', 0, 4.5, 89, 66, 311, 10, 2, 0, 0.1, 2.6),
+    ('Lamb Liver', 0, 1.9, 371, 70, 313, 7, 7.4, 4, 0.5, 90),
+    ('Lamb Shank', 0, 5.5, 85, 65, 300, 15, 2, 0, 0.1, 2.4),
+    ('Lamb Shoulder', 0, 6.8, 80, 70, 290, 17, 1.9, 0, 0.1, 2.5),
+    ('Langoustine', 0, 0.3, 150, 400, 260, 100, 0.6, 0, 0, 1.5),
+    ('Langsat', 11, 0.02, 0, 1, 100, 20, 0.9, 1, 0, 0),
+    ('Lard', 0, 39, 95, 0, 0, 0, 0, 0, 2.5, 0),
+    ('Lavash', 1, 0.5, 0, 500, 130, 30, 3.5, 0, 0, 0),
+    ('Leek', 3.9, 0.04, 0, 20, 180, 59, 2.1, 12, 0, 0),
+    ('Lemon Juice', 2.5, 0.04, 0, 1, 103, 6, 0.1, 38.7, 0, 0),
+    ('Lentils', 1.8, 0.05, 0, 2, 369, 19, 3.3, 1.5, 0, 0),
+    ('Lettuce', 0.8, 0.02, 0, 28, 194, 36, 0.9, 9.2, 0, 0),
+    ('Licorice', 50, 0, 0, 250, 40, 5, 0.4, 0, 0, 0),
+    ('Lima Beans', 2.9, 0.1, 0, 2, 508, 17, 2.4, 0, 0, 0),
+    ('Lime Juice', 1.7, 0.01, 0, 2, 117, 14, 0.1, 30, 0, 0),
+    ('Lingonberry', 5.5, 0.02, 0, 1, 90, 26, 0.4, 11, 0, 0),
+    ('Liverwurst', 2.2, 10.6, 158, 860, 170, 26, 6.4, 0, 1.3, 13.5),
+    ('Lobster', 0, 0.2, 146, 486, 230, 96, 0.3, 0, 0, 1.4),
+    ('Loganberry', 5.9, 0.01, 0, 1, 145, 26, 0.6, 15.3, 0, 0),
+    ('Lollipop', 63, 0, 0, 38, 5, 3, 0.3, 0, 0, 0),
+    ('Longan', 14, 0.02, 0, 0, 266, 1, 0.1, 84, 0, 0),
+    ('Loquat', 8, 0.04, 0, 1, 266, 16, 0.3, 1, 0, 0),
+    ('Lotus Root', 0, 0.03, 0, 40, 556, 45, 1.2, 44, 0, 0),
+    ('Lotus Seed', 0, 0.1, 0, 1, 367, 44, 1, 0, 0, 0),
+    ('Lovage', 2, 0.1, 0, 20, 400, 100, 2, 30, 0, 0),
+    ('Lychee', 15.2, 0.1, 0, 1, 171, 5, 0.3, 71.5, 0, 0),
+    ('Macadamia Nut', 4.6, 12.1, 0, 5, 368, 85, 3.7, 1.2, 0, 0),
+    ('Macadamia Oil', 0, 16, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Macaron', 45, 3, 20, 50, 200, 70, 1.5, 0, 0, 0.1),
+    ('Mackerel', 0, 3.3, 70, 90, 314, 12, 1.6, 0.4, 16, 8.7),
+    ('Malabar Spinach', 0.5, 0.05, 0, 24, 510, 109, 1.2, 102, 0, 0),
+    ('Malt Syrup', 37, 0, 0, 35, 320, 60, 1, 0, 0, 0),
+    ('Mamey Sapote', 20, 0.1, 0, 7, 454, 22, 0.8, 23, 0, 0),
+    ('Manchego Cheese', 0.5, 21, 100, 670, 100, 830, 0.3, 0, 0.5, 1.2),
+    ('Mango', 13.7, 0.09, 0, 1, 168, 11, 0.2, 36.4, 0, 0),
+    ('Mangosteen', 16, 0.05, 0, 7, 48, 12, 0.3, 2.9, 0, 0),
+    ('Maple Candy', 85, 0, 0, 10, 200, 70, 0.5, 0, 0, 0),
+    ('Maple Syrup', 60, 0, 0, 12, 212, 102, 0.1, 0, 0, 0),
+    ('Maqui Berry', 7, 0.05, 0, 5, 150, 40, 1, 10, 0, 0),
+    ('Margarine', 0, 15, 0, 700, 20, 10, 0, 0, 0, 0),
+    ('Marinara Sauce', 4.5, 0.2, 0, 430, 320, 20, 0.8, 8, 0, 0),
+    ('Marionberry', 7, 0.02, 0, 1, 160, 30, 0.7, 15, 0, 0),
+    ('Marmalade', 60, 0, 0, 56, 37, 38, 0.2, 4.8, 0, 0),
+    ('Marshmallow', 58, 0, 0, 80, 5, 3, 0.2, 0, 0, 0),
+    ('Marshmallow Fluff', 58, 0, 0, 80, 5, 3, 0.2, 0, 0, 0),
+    ('Marzipan', 55, 1.8, 0, 10, 300, 100, 1.5, 0, 0, 0),
+    ('Mascarpone', 4, 30, 110, 40, 100, 100, 0.1, 0, 0.6, 0.3),
+    ('Matzo', 0.3, 0.2, 0, 2, 110, 13, 3.2, 0, 0, 0),
+    ('Mayonnaise', 0.6, 11.7, 42, 635, 20, 8, 0.2, 0, 0.2, 0.1),
+    ('Medlar', 7, 0.02, 0, 1, 250, 30, 0.3, 2, 0, 0),
+    ('Meringue', 85, 0, 0, 150, 140, 5, 0.1, 0, 0, 0),
+    ('Milk', 5, 0.6, 5, 44, 150, 125, 0, 0, 1.3, 0.5),
+    ('Milk Chocolate', 51.5, 18.5, 23, 79, 372, 189, 2.4, 0, 0, 0.8),
+    ('Milk Toffee', 60, 7, 25, 150, 200, 100, 0.2, 0, 0.2, 0.2),
+    ('Millet', 1.7, 0.7, 0, 5, 195, 8, 3, 0, 0, 0),
+    ('Millet Flour', 1.7, 0.5, 0, 4, 224, 14, 4, 0, 0, 0),
+    ('Mint', 0, 0.2, 0, 31, 569, 243, 5.1, 31.8, 0, 0),
+    ('Mirabelle Plum', 10, 0.02, 0, 0, 157, 6, 0.2, 9.5, 0, 0),
+    ('Miso Paste', 6.2, 1, 0, 3728, 210, 57, 2.5, 0, 0, 0.1),
+    ('Mizuna', 2, 0.02, 0, 30, 500, 200, 1.5, 40, 0, 0),
+    ('Molasses', 74.7, 0, 0, 37, 1464, 205, 4.7, 0, 0, 0),
+    ('Monkfish', 0, 0.3, 25, 18, 400, 8, 0.3, 1, 0, 0.9),
+    ('Mortadella', 0, 10, 56, 1250, 163, 18, 1.4, 0, 0.5, 1.5),
+    ('Mozzarella', 1, 10.9, 79, 627, 76, 505, 0.4, 0, 0.4, 2.3),
+    ('Mozzarella Cheese', 1, 13.2, 79, 627, 76, 505, 0.4, 0, 0.4, 2.3),
+    ('Muesli', 22, 1, 0, 200, 400, 50, 4, 1, 0, 0),
+    ('Muffin', 26, 3, 40, 350, 100, 50, 1.5, 0, 0.3, 0.2),
+    ('Mulberry', 8.1, 0.03, 0, 10, 194, 39, 1.9, 36.4, 0, 0),
+    ('Multigrain Bread', 6, 0.7, 0, 400, 230, 100, 2.5, 0, 0, 0),
+    ('Mung Beans', 2, 0.1, 0, 2, 266, 27, 1.4, 1, 0, 0),
+    ('Mushroom', 2, 0.05, 0, 5, 318, 3, 0.5, 2.1, 0.2, 0.04),
+    ('Mushroom Broth', 0.5, 0, 0, 300, 80, 3, 0.1, 0, 0, 0),
+    ('Mussel', 0, 0.9, 56, 369, 268, 33, 6.7, 13, 0, 24),
+    ('Mustard', 6, 2, 0, 5, 738, 266, 9.2, 7, 0, 0),
+    ('Mustard Greens', 1.3, 0.01, 0, 20, 384, 115, 1.6, 70, 0, 0),
+    ('Mustard Oil', 0, 11.6, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Mutton', 0, 9.5, 97, 72, 310, 17, 2, 0, 0.1, 2.6),
+    ('Naan', 3.5, 1.5, 5, 465, 125, 80, 3.3, 0, 0, 0),
+    ('Napa Cabbage', 1.4, 0.04, 0, 9, 238, 77, 0.3, 27, 0, 0),
+    ('Navy Beans', 0.4, 0.2, 0, 0, 389, 69, 2.4, 0.9, 0, 0),
+    ('Nougat', 50, 4, 5, 50, 100, 40, 0.5, 0, 0, 0),
+    ('Nutella', 56.3, 10.6, 0, 41, 407, 108, 4.4, 0, 0, 0),
+    ('Oat Bran', 1.5, 1.3, 0, 4, 566, 58, 5.4, 0, 0, 0),
+    ('Oat Flour', 0.8, 1.7, 0, 19, 371, 55, 4, 0, 0, 0),
+    ('Oat Milk', 4, 0.2, 0, 40, 150, 120, 0.3, 0, 1.1, 0.4),
+    ('Oats', 1, 1.2, 0, 2, 429, 54, 4.7, 0, 0, 0),
+    ('Octopus', 0, 0.2, 48, 230, 350, 53, 5.3, 5, 0, 20),
+    ('Okra', 1.5, 0.03, 0, 7, 299, 82, 0.6, 23, 0, 0),
+    ('Olive Oil', 0, 13.8, 0, 2, 1, 1, 0.6, 0, 0, 0),
+    ('Onion', 4.2, 0.04, 0, 4, 146, 23, 0.2, 7.4, 0, 0),
+    ('Orange', 9.4, 0.02, 0, 0, 181, 40, 0.1, 53.2, 0, 0),
+    ('Orange Juice', 8.4, 0.02, 0, 1, 200, 11, 0.2, 50, 0, 0),
+    ('Oregano', 4.1, 1.6, 0, 25, 1260, 1597, 36.8, 2.3, 0, 0),
+    ('Ostrich', 0, 1, 70, 70, 300, 6, 3.2, 0, 0, 5.5),
+    ('Oyster', 0, 0.5, 53, 211, 156, 59, 5.8, 8, 8, 16),
+    ('Padron Pepper', 3, 0.03, 0, 5, 250, 10, 0.5, 90, 0, 0),
+    ('Pak Choi', 1.2, 0.03, 0, 65, 252, 105, 0.8, 45, 0, 0),
+    ('Palm Oil', 0, 49.3, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Palm Sugar', 75, 0, 0, 45, 1030, 8, 0.3, 0, 0, 0),
+    ('Pancake', 6, 2.2, 60, 440, 130, 200, 1.8, 0.2, 0.2, 0.2),
+    ('Paneer', 2, 13, 60, 20, 100, 480, 0.2, 0, 0.2, 0.6),
+    ('Papaya', 7.8, 0.08, 0, 8, 182, 20, 0.3, 60.9, 0, 0),
+    ('Paratha', 2, 5, 0, 450, 150, 40, 2.5, 0, 0, 0),
+    ('Parmesan Cheese', 0.9, 19, 88, 1529, 125, 1184, 0.8, 0, 0.5, 1.2),
+    ('Parsley', 0.9, 0.13, 0, 56, 554, 138, 6.2, 133, 0, 0),
+    ('Parsley Root', 3, 0.1, 0, 10, 375, 36, 0.6, 17, 0, 0),
+    ('Parsnip', 4.8, 0.05, 0, 10, 375, 36, 0.6, 17, 0, 0),
+    ('Partridge', 0, 1, 80, 60, 300, 15, 5, 0, 0, 1),
+    ('Passion Fruit', 11.2, 0.06, 0, 28, 348, 12, 1.6, 30, 0, 0),
+    ('Pasta', 0.6, 0.2, 0, 1, 44, 7, 1.3, 0, 0, 0),
+    ('Pastrami', 1, 2.5, 68, 1100, 250, 8, 2.2, 0, 0.2, 1.7),
+    ('Pastry Flour', 0.3, 0.1, 0, 2, 105, 14, 7.3, 0, 0, 0),
+    ('Pattypan Squash', 2.2, 0.04, 0, 1, 182, 19, 0.4, 18, 0, 0),
+    ('Pawpaw', 6, 0.3, 0, 5, 345, 63, 7, 18.3, 0, 0),
+    ('Pea Shoots', 1.5, 0.05, 0, 10, 200, 30, 1, 40, 0, 0),
+    ('Peach', 8.4, 0.02, 0, 0, 190, 6, 0.3, 6.6, 0, 0),
+    ('Peanut Brittle', 45, 3, 10, 400, 150, 25, 1.2, 0, 0, 0),
+    ('Peanut Butter', 9.2, 10.1, 0, 17, 558, 49, 1.7, 0, 0, 0),
+    ('Peanut Oil', 0, 16.9, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Peanuts', 4.7, 6.3, 0, 18, 705, 92, 4.6, 0, 0, 0),
+    ('Pear', 9.8, 0.02, 0, 1, 116, 9, 0.2, 4.3, 0, 0),
+    ('Peas', 5.7, 0.07, 0, 5, 244, 25, 1.5, 40, 0, 0),
+    ('Pecan', 4, 6.2, 0, 0, 410, 70, 2.5, 1.1, 0, 0),
+    ('Pecan Pie', 30, 4.5, 60, 300, 140, 30, 1.2, 0, 0.2, 0.1),
+    ('Pepperoncini', 4, 0.03, 0, 1200, 200, 10, 0.5, 50, 0, 0),
+    ('Pepperoni', 0, 17.7, 97, 1582, 274, 19, 1.3, 0, 0.5, 1.4),
+    ('Pequi', 2, 8, 0, 5, 200, 30, 0.4, 12, 0, 0),
+    ('Perch', 0, 0.2, 90, 62, 269, 80, 0.9, 1.7, 0, 1.9),
+    ('Persimmon', 12.5, 0.02, 0, 1, 161, 8, 0.2, 7.5, 0, 0),
+    ('Pesto', 3, 5.3, 10, 707, 250, 300, 1.5, 1, 0, 0.2),
+    ('Pheasant', 0, 1, 66, 37, 260, 13, 1.1, 6, 0, 0.8),
+    ('Pho Broth', 0.3, 0.2, 5, 400, 100, 5, 0.3, 0, 0, 0.1),
+    ('Physalis', 7, 0.1, 0, 0, 200, 9, 1, 11, 0, 0),
+    ('Pickle Juice', 1, 0, 0, 1200, 50, 10, 0.1, 0, 0, 0),
+    ('Pigeon', 0, 1.5, 90, 51, 285, 13, 4.5, 6, 0, 0.4),
+    ('Pigeon Peas', 0, 0.3, 0, 17, 1392, 130, 5.2, 0, 0, 0),
+    ('Pili Nut', 4, 31, 0, 3, 507, 145, 3.5, 0.6, 0, 0),
+    ('Pine Nut', 3.6, 4.9, 0, 2, 597, 16, 5.5, 0.8, 0, 0),
+    ('Pineapple', 9.9, 0.01, 0, 1, 109, 13, 0.3, 47.8, 0, 0),
+    ('Pinto Beans', 0.3, 0.2, 0, 1, 436, 46, 2.1, 0.8, 0, 0),
+    ('Pistachio Oil', 0, 12, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Pistachio Turkish Delight', 70, 0.3, 0, 30, 60, 10, 0.4, 0, 0, 0),
+    ('Pita Bread', 1.3, 0.2, 0, 536, 120, 86, 2.6, 0, 0, 0),
+    ('Plaice', 0, 0.2, 42, 120, 280, 30, 0.3, 0, 1.7, 1.3),
+    ('Plantain', 15, 0.14, 0, 4, 499, 3, 0.6, 18.4, 0, 0),
+    ('Plum', 9.9, 0.02, 0, 0, 157, 6, 0.2, 9.5, 0, 0),
+    ('Poblano Pepper', 3.5, 0.02, 0, 3, 220, 12, 0.5, 80, 0, 0),
+    ('Pointed Gourd', 1, 0.02, 0, 2, 80, 30, 1.7, 29, 0, 0),
+    ('Polenta', 0.3, 0.03, 0, 1, 30, 1, 0.3, 0, 0, 0),
+    ('Pollock', 0, 0.1, 71, 99, 329, 60, 0.5, 0, 1.1, 3.2),
+    ('Pomegranate', 13.7, 0.12, 0, 3, 236, 10, 0.3, 10.2, 0, 0),
+    ('Pomelo', 7, 0, 0, 1, 216, 4, 0.1, 61, 0, 0),
+    ('Poppyseed Oil', 0, 13.5, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Pork (lean)', 0, 5, 80, 62, 370, 19, 0.9, 0.6, 0.5, 0.7),
+    ('Pork Belly', 0, 19.3, 72, 32, 185, 5, 0.5, 0.3, 0.5, 0.6),
+    ('Pork Chop', 0, 5, 80, 55, 360, 19, 0.8, 0, 0.6, 0.6),
+    ('Pork Ham', 1, 2, 53, 1200, 290, 8, 0.9, 0, 0.7, 0.4),
+    ('Pork Ribs', 0, 10, 80, 81, 320, 24, 1.1, 0, 0.5, 0.6),
+    ('Pork Sausage', 1, 9, 69, 750, 250, 14, 1, 0, 0.7, 0.9),
+    ('Pork Shoulder', 0, 5.5, 80, 70, 320, 14, 1.3, 0, 0.5, 0.8),
+    ('Pork Tenderloin', 0, 1.7, 65, 50, 400, 5, 1, 0, 0.5, 0.5),
+    ('Porridge', 0.3, 0.3, 0, 49, 70, 9, 0.9, 0, 0, 0),
+    ('Potato', 0.8, 0.03, 0, 6, 425, 12, 0.8, 19.7, 0, 0),
+    ('Potato Flour', 3.5, 0.1, 0, 55, 1001, 65, 1.4, 3.8, 0, 0),
+    ('Powdered Milk', 38, 16.7, 97, 371, 1330, 912, 0.5, 8.6, 0.5, 3.3),
+    ('Powdered Sugar', 97.8, 0, 0, 2, 2, 1, 0.1, 0, 0, 0),
+    ('Pretzel', 2.8, 0.3, 0, 1240, 146, 36, 4.3, 0, 0, 0),
+    ('Profiterole', 20, 9, 130, 200, 100, 60, 1, 0, 0.5, 0.3),
+    ('Prosciutto', 0, 6.2, 86, 2260, 450, 10, 0.9, 0, 0.6, 0.9),
+    ('Provolone Cheese', 0.6, 17, 69, 876, 138, 756, 0.5, 0, 0.5, 1.5),
+    ('Prune', 38, 0.09, 0, 2, 732, 43, 0.9, 0.6, 0, 0),
+    ('Pumpernickel', 0.5, 0.4, 0, 596, 208, 68, 2.9, 0, 0, 0),
+    ('Pumpernickel Bread', 0.5, 0.4, 0, 596, 208, 68, 2.9, 0, 0, 0),
+    ('Pumpkin', 2.8, 0.05, 0, 1, 340, 21, 0.8, 9, 0, 0),
+    ('Pumpkin Puree', 3.3, 0.1, 0, 5, 206, 26, 1.4, 4.2, 0, 0),
+    ('Pumpkin Seed Oil', 0, 18, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Pumpkin Seeds', 1.4, 8.7, 0, 7, 809, 46, 8.8, 1.9, 0, 0),
+    ('Purslane', 0, 0.07, 0, 45, 494, 65, 2, 21, 0, 0),
+    ('Quail', 0, 1.3, 76, 53, 237, 13, 4.5, 6, 0, 0.4),
+    ('Quark', 3.5, 1.8, 10, 40, 150, 90, 0.1, 0, 0, 0.5),
+    ('Queso Fresco', 2, 14, 69, 751, 129, 566, 0.2, 0, 0.3, 0.6),
+    ('Quince', 12, 0.01, 0, 4, 197, 11, 0.7, 15, 0, 0),
+    ('Quinoa', 0.9, 0.23, 0, 7, 172, 17, 1.5, 0, 0, 0),
+    ('Quinoa Flour', 4, 0.7, 0, 5, 563, 47, 4.6, 0, 0, 0),
+    ('Rabbit', 0, 1.2, 123, 45, 383, 20, 4.6, 0, 0, 8.3),
+    ('Radicchio', 0.6, 0.05, 0, 22, 302, 19, 0.6, 8, 0, 0),
+    ('Radish', 1.9, 0.01, 0, 39, 233, 25, 0.3, 14.8, 0, 0),
+    ('Raisin', 59.2, 0.06, 0, 11, 749, 50, 1.9, 2.3, 0, 0),
+    ('Rambutan', 15, 0.02, 0, 11, 42, 22, 0.4, 4.9, 0, 0),
+    ('Ramen Broth', 0.5, 0.4, 5, 700, 120, 10, 0.3, 0, 0, 0.1),
+    ('Ramen Noodles', 2, 7.6, 0, 1800, 120, 20, 4, 0, 0, 0),
+    ('Rapeseed Oil', 0, 7.4, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Rapini', 0.4, 0.05, 0, 33, 196, 108, 2.1, 20, 0, 0),
+    ('Razor Clam', 0, 0.2, 40, 300, 300, 60, 10, 0, 0, 30),
+    ('Red Cabbage', 3.8, 0.02, 0, 27, 243, 45, 0.8, 57, 0, 0),
+    ('Red Lentils', 1.8, 0.05, 0, 2, 369, 19, 3.3, 1.5, 0, 0),
+    ('Red Palm Oil', 0, 49.3, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Red Rice', 0.5, 0.5, 0, 5, 250, 15, 2.5, 0, 0, 0),
+    ('Relish', 21, 0.03, 0, 811, 25, 3, 0.9, 1, 0, 0),
+    ('Rice Bran', 0.9, 4.2, 0, 5, 1485, 57, 18.5, 0, 0, 0),
+    ('Rice Bran Oil', 0, 19.7, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Rice Cake', 0.9, 0.6, 0, 29, 290, 11, 1.5, 0, 0, 0),
+    ('Rice Flour', 0.1, 0.4, 0, 0, 76, 10, 0.4, 0, 0, 0),
+    ('Rice Milk', 5.3, 0, 0, 39, 27, 118, 0.2, 0, 1, 0.6),
+    ('Rice Noodles', 0, 0.02, 0, 19, 4, 4, 0.1, 0, 0, 0),
+    ('Rice Paper', 0, 0, 0, 30, 5, 5, 0.2, 0, 0, 0),
+    ('Rice Syrup', 45, 0, 0, 20, 20, 5, 0.1, 0, 0, 0),
+    ('Ricotta Cheese', 0.3, 8.3, 51, 84, 105, 207, 0.4, 0, 0.2, 0.3),
+    ('Roast Beef', 0, 4.5, 80, 800, 300, 10, 2, 0, 0.1, 2),
+    ('Rock Candy', 98, 0, 0, 1, 2, 1, 0, 0, 0, 0),
+    ('Rocky Road', 50, 8, 5, 100, 200, 50, 1.5, 0, 0, 0.1),
+    ('Roe', 0, 0.9, 374, 91, 221, 22, 0.6, 16, 12, 10),
+    ('Romanesco', 1.5, 0.05, 0, 20, 300, 30, 0.6, 60, 0, 0),
+    ('Romanian Pepper', 3.5, 0.03, 0, 3, 200, 10, 0.4, 100, 0, 0),
+    ('Rose Hip', 2.6, 0.02, 0, 4, 429, 169, 1.1, 426, 0, 0),
+    ('Rosemary', 0, 2.8, 0, 26, 668, 317, 6.7, 21.8, 0, 0),
+    ('Rowan Berry', 6, 0.02, 0, 2, 230, 40, 1.5, 34, 0, 0),
+    ('Rutabaga', 4.5, 0.03, 0, 12, 305, 43, 0.4, 25, 0, 0),
+    ('Rye', 1, 0.2, 0, 2, 510, 24, 2.6, 0, 0, 0),
+    ('Rye Bread', 3.9, 0.6, 0, 603, 166, 73, 2.8, 0.4, 0, 0),
+    ('Rye Flour', 1, 0.2, 0, 2, 396, 24, 2.5, 0, 0, 0),
+    ('Safflower Oil', 0, 7.5, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Sago', 0, 0, 0, 1, 11, 10, 1.2, 0, 0, 0),
+    ('Sago Flour', 0, 0, 0, 1, 11, 10, 1.2, 0, 0, 0),
+    ('Salak', 15, 0.1, 0, 1, 300, 28, 4.2, 8, 0, 0),
+    ('Salami', 1, 12, 79, 1740, 340, 13, 1.5, 0, 0.5, 2.8),
+    ('Salmon', 0, 3.1, 55, 59, 363, 9, 0.3, 3.9, 11, 3.2),
+    ('Salmonberry', 7, 0.02, 0, 1, 150, 20, 0.5, 15, 0, 0),
+    ('Salsa', 4, 0.03, 0, 600, 275, 30, 0.4, 4, 0, 0),
+    ('Salsify', 2.9, 0.03, 0, 20, 380, 60, 0.7, 8, 0, 0),
+    ('Salt', 0, 0, 0, 38758, 8, 24, 0.3, 0, 0, 0),
+    ('Samphire', 1, 0.1, 0, 1500, 400, 80, 2, 8, 0, 0),
+    ('Santol', 12, 0.02, 0, 1, 330, 4, 0.4, 14, 0, 0),
+    ('Sapote', 20, 0.1, 0, 7, 200, 22, 0.4, 190, 0, 0),
+    ('Sardines', 0, 1.5, 142, 307, 397, 382, 2.9, 0, 4.8, 8.9),
+    ('Saskatoon Berry', 11, 0.03, 0, 1, 160, 42, 1, 4, 0, 0),
+    ('Satsuma', 10.6, 0.04, 0, 2, 166, 37, 0.2, 26.7, 0, 0),
+    ('Scallion', 2.3, 0.03, 0, 16, 276, 72, 1.5, 18.8, 0, 0),
+    ('Scallop', 0, 0.2, 24, 392, 205, 12, 0.4, 0, 0, 1.5),
+    ('Schmaltz', 0, 30, 85, 0, 0, 0, 0, 0, 0, 0),
+    ('Scone', 15, 7, 50, 650, 110, 200, 2.5, 0, 0.3, 0.2),
+    ('Scotch Bonnet', 5.3, 0.04, 0, 9, 322, 14, 1, 144, 0, 0),
+    ('Sea Asparagus', 1, 0.1, 0, 1500, 400, 80, 2, 8, 0, 0),
+    ('Sea Bass', 0, 0.5, 41, 68, 256, 10, 0.3, 0, 0, 0.3),
+    ('Sea Buckthorn', 2, 1.5, 0, 4, 160, 30, 0.5, 200, 0, 0),
+    ('Sea Urchin', 0, 0.6, 100, 220, 300, 30, 0.8, 1, 0, 3),
+    ('Seaweed', 0.6, 0.2, 0, 233, 89, 168, 2.9, 3, 0, 0),
+    ('Seitan', 0, 0.3, 0, 29, 100, 142, 5.2, 0, 0, 0),
+    ('Self-Rising Flour', 0.2, 0.2, 0, 1270, 124, 338, 4.8, 0, 0, 0),
+    ('Semolina', 0, 0.2, 0, 1, 186, 17, 1.2, 0, 0, 0),
+    ('Semolina Flour', 0, 0.2, 0, 1, 186, 17, 1.2, 0, 0, 0),
+    ('Serrano Pepper', 3.8, 0.06, 0, 10, 305, 11, 0.9, 44.9, 0, 0),
+    ('Serviceberry', 11, 0.03, 0, 1, 160, 42, 1, 4, 0, 0),
+    ('Sesame Halva', 45, 5, 0, 195, 200, 60, 1.8, 0, 0, 0),
+    ('Sesame Oil', 0, 14.2, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Sesame Paste (Tahini)', 0.5, 7, 0, 115, 414, 426, 8.9, 4.2, 0, 0),
+    ('Sesame Seeds', 0.3, 7, 0, 11, 468, 975, 14.6, 0, 0, 0),
+    ('Shallot', 7.9, 0.02, 0, 12, 334, 37, 1.2, 8, 0, 0),
+    ('Sheep Milk', 5.1, 4.6, 27, 44, 137, 193, 0.1, 4.2, 0, 0.7),
+    ('Shirataki Noodles', 0, 0, 0, 5, 10, 20, 0.2, 0, 0, 0),
+    ('Shortbread', 18, 16, 70, 400, 100, 30, 2, 0, 0.4, 0.1),
+    ('Shortening', 0, 25, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Shrimp', 0, 0.1, 189, 111, 259, 70, 0.5, 0, 0, 1.1),
+    ('Skim Milk', 5, 0.1, 2, 42, 156, 122, 0, 0, 1.2, 0.5),
+    ('Snake Fruit', 15, 0.1, 0, 1, 300, 28, 4.2, 8, 0, 0),
+    ('Snake Gourd', 2, 0.03, 0, 33, 359, 51, 0.3, 18, 0, 0),
+    ('Snapper', 0, 0.3, 47, 57, 522, 40, 0.2, 1.6, 0, 3.5),
+    ('Snow Peas', 4, 0.04, 0, 4, 200, 43, 2.1, 60, 0, 0),
+    ('Soba Noodles', 0.5, 0.02, 0, 60, 35, 4, 0.5, 0, 0, 0),
+    ('Sole', 0, 0.2, 45, 81, 197, 18, 0.2, 0, 1.7, 1.3),
+    ('Soppressata', 1, 13, 90, 1800, 350, 15, 1.5, 0, 0.5, 1.5),
+    ('Sorbitol', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Sorghum', 2.5, 0.6, 0, 2, 363, 13, 3.4, 0, 0, 0),
+    ('Sorghum Flour', 2.5, 0.6, 0, 3, 324, 12, 3.2, 0, 0, 0),
+    ('Sorrel', 0, 0.02, 0, 4, 390, 44, 2.4, 48, 0, 0),
+    ('Sour Cream', 3.4, 11, 59, 31, 125, 101, 0.1, 0.9, 0.1, 0.3),
+    ('Sourdough Bread', 5, 0.2, 0, 600, 117, 20, 3.7, 0, 0, 0),
+    ('Soursop', 13.5, 0.05, 0, 14, 278, 14, 0.6, 20.6, 0, 0),
+    ('Soy Flour', 7.5, 2.6, 0, 13, 2500, 206, 6.4, 0, 0, 0),
+    ('Soy Milk', 4, 0.2, 0, 51, 118, 123, 0.4, 0, 1.1, 1.1),
+    ('Soy Sauce', 0.4, 0.1, 0, 5493, 435, 33, 1.5, 0, 0, 0),
+    ('Soybean Oil', 0, 15.6, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Soybeans', 7.3, 2.9, 0, 2, 1797, 277, 15.7, 6, 0, 0),
+    ('Spaghetti Squash', 2.8, 0.1, 0, 17, 108, 23, 0.3, 2.1, 0, 0),
+    ('Spelt', 6.8, 0.4, 0, 8, 388, 27, 4.4, 0, 0, 0),
+    ('Spelt Flour', 6.8, 0.4, 0, 8, 388, 27, 4.4, 0, 0, 0),
+    ('Spinach', 0.4, 0.06, 0, 79, 558, 99, 2.7, 28.1, 0, 0),
+    ('Split Peas', 2.9, 0.05, 0, 2, 362, 14, 1.3, 0.4, 0, 0),
+    ('Spring Onion', 2.3, 0.03, 0, 16, 276, 72, 1.5, 18.8, 0, 0),
+    ('Squid', 0, 0.4, 233, 44, 246, 32, 0.7, 4.7, 0, 1.3),
+    ('Sriracha', 15, 0.2, 0, 2120, 320, 18, 1.6, 26, 0, 0),
+    ('Starfruit', 4, 0.02, 0, 2, 133, 3, 0.1, 34, 0, 0),
+    ('Stevia', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Sticky Rice', 0.1, 0.1, 0, 7, 77, 11, 1.5, 0, 0, 0),
+    ('Strawberry', 4.9, 0.02, 0, 1, 153, 16, 0.4, 58.8, 0, 0),
+    ('Suckling Pig', 0, 11, 80, 60, 250, 10, 1, 0, 0.5, 0.6),
+    ('Sucralose', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Sugar', 99.8, 0, 0, 1, 2, 1, 0.1, 0, 0, 0),
+    ('Sugar Apple', 14, 0.05, 0, 9, 247, 24, 0.6, 36.3, 0, 0),
+    ('Sugar Snap Peas', 4, 0.04, 0, 4, 200, 43, 2.1, 60, 0, 0),
+    ('Sunflower Oil', 0, 10.3, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Sunflower Seeds', 2.6, 4.5, 0, 9, 645, 78, 5.3, 1.4, 0, 0),
+    ('Sushi Rice', 0.1, 0.2, 0, 1, 86, 3, 0.8, 0, 0, 0),
+    ('Sweet Corn', 6.3, 0.3, 0, 15, 270, 2, 0.5, 6.8, 0, 0),
+    ('Sweet Potato', 4.2, 0.02, 0, 55, 337, 30, 0.6, 2.4, 0, 0),
+    ('Sweetbreads', 0, 6.8, 223, 116, 433, 7, 1.7, 28, 0, 3.4),
+    ('Swiss Chard', 1.1, 0.03, 0, 213, 379, 51, 1.8, 30, 0, 0),
+    ('Swiss Cheese', 1.3, 17.8, 93, 192, 77, 890, 0.1, 0, 0.5, 3.1),
+    ('Swordfish', 0, 1.9, 66, 97, 499, 6, 0.4, 1, 14, 1.9),
+    ('Tabasco', 1.3, 0.1, 0, 633, 128, 8, 1.1, 4.5, 0, 0),
+    ('Tahini', 0.5, 7, 0, 115, 414, 426, 8.9, 4.2, 0, 0),
+    ('Tamarind', 57.4, 0.3, 0, 28, 628, 74, 2.8, 3.5, 0, 0),
+    ('Tangerine', 10.6, 0.04, 0, 2, 166, 37, 0.2, 26.7, 0, 0),
+    ('Tapioca', 3.4, 0, 0, 1, 11, 20, 1.6, 0, 0, 0),
+    ('Tapioca Flour', 3.4, 0, 0, 1, 11, 20, 1.6, 0, 0, 0),
+    ('Taro', 0.4, 0.04, 0, 11, 591, 43, 0.6, 4.5, 0, 0),
+    ('Tat Soi', 1, 0.03, 0, 50, 450, 210, 1.5, 130, 0, 0),
+    ('Tayberry', 6, 0.02, 0, 1, 160, 30, 0.7, 15, 0, 0),
+    ('Tea', 0, 0, 0, 3, 37, 0, 0, 0, 0, 0),
+    ('Teff', 1.8, 0.4, 0, 12, 427, 180, 7.6, 0, 0, 0),
+    ('Teff Flour', 1.8, 0.4, 0, 12, 427, 180, 7.6, 0, 0, 0),
+    ('Tempeh', 0, 2.2, 0, 9, 412, 111, 2.7, 0, 0, 0.1),
+    ('Teriyaki Sauce', 14, 0, 0, 3833, 225, 25, 1.7, 0, 0, 0),
+    ('Thyme', 1.7, 0.5, 0, 9, 609, 405, 17.5, 160, 0, 0),
+    ('Tiger Nut', 17, 4.3, 0, 35, 714, 70, 3.4, 6, 0, 0),
+    ('Tilapia', 0, 0.8, 50, 52, 302, 10, 0.6, 0, 3.1, 1.6),
+    ('Tinda', 2, 0.02, 0, 2, 150, 25, 0.9, 18, 0, 0),
+    ('Toffee', 60, 8, 30, 300, 80, 60, 0.2, 0, 0.2, 0.1),
+    ('Tofu', 0.6, 0.7, 0, 7, 121, 350, 5.4, 0.1, 0, 0),
+    ('Tomatillo', 3.9, 0.14, 0, 1, 268, 7, 0.6, 11.7, 0, 0),
+    ('Tomato', 2.6, 0.03, 0, 5, 237, 10, 0.3, 13.7, 0, 0),
+    ('Tomato Sauce', 4.2, 0.03, 0, 474, 331, 14, 1, 7, 0, 0),
+    ('Tortilla', 0.9, 0.4, 0, 45, 186, 81, 1.2, 0, 0, 0),
+    ('Tripe', 0, 1.3, 122, 97, 67, 69, 0.6, 0, 0, 1.4),
+    ('Triticale', 1, 0.4, 0, 5, 332, 37, 2.6, 0, 0, 0),
+    ('Triticale Flakes', 1, 0.4, 0, 5, 332, 37, 2.6, 0, 0, 0),
+    ('Trout', 0, 1.5, 58, 52, 481, 43, 0.3, 2, 15.9, 4.5),
+    ('Tuna', 0, 0.2, 47, 50, 444, 4, 0.8, 1, 2, 9.4),
+    ('Turban Squash', 2.2, 0.02, 0, 3, 347, 33, 0.7, 11, 0, 0),
+    ('Turbot', 0, 0.8, 48, 150, 238, 18, 0.4, 1.7, 0, 2.2),
+    ('Turkey Bacon', 2, 8.5, 110, 2000, 390, 12, 1.7, 0, 0.4, 0.5),
+    ('Turkey Breast', 0, 0.3, 62, 55, 293, 10, 0.7, 0, 0.1, 0.4),
+    ('Turkey Burger', 0, 4, 80, 80, 250, 20, 1.3, 0, 0.3, 1.1),
+    ('Turkey Leg', 0, 2.5, 85, 77, 240, 20, 1.5, 0, 0.3, 1.3),
+    ('Turkey Sausage', 1, 3.5, 70, 860, 260, 20, 1.4, 0, 0.3, 1.1),
+    ('Turkey Thigh', 0, 2.5, 85, 77, 240, 20, 1.5, 0, 0.3, 1.3),
+    ('Turkish Delight', 70, 0.1, 0, 20, 10, 5, 0.2, 0, 0, 0),
+    ('Turmeric', 3.2, 3.1, 0, 27, 2080, 168, 55, 0.7, 0, 0),
+    ('Turnip', 3.8, 0.01, 0, 67, 191, 30, 0.3, 21, 0, 0),
+    ('Turnip Greens', 0.8, 0.07, 0, 40, 296, 190, 1.1, 60, 0, 0),
+    ('Turron', 45, 2, 0, 30, 400, 120, 1.8, 0, 0, 0),
+    ('Udon Noodles', 0.4, 0.04, 0, 150, 30, 8, 0.4, 0, 0, 0),
+    ('Ugli Fruit', 8, 0.02, 0, 0, 160, 40, 0.1, 45, 0, 0),
+    ('Veal', 0, 2.9, 103, 82, 315, 13, 1.1, 0, 0.3, 1.3),
+    ('Vegetable Broth', 0.5, 0.02, 0, 300, 60, 5, 0.1, 0, 0, 0),
+    ('Vegetable Oil', 0, 10, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Vegetable Shortening', 0, 25, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Vegetable Stock', 1, 0.1, 0, 300, 80, 10, 0.2, 1, 0, 0),
+    ('Venison', 0, 1.2, 112, 54, 335, 7, 4.5, 0, 0, 3.2),
+    ('Vienna Sausage', 1, 6, 80, 900, 100, 10, 0.9, 0, 0.3, 0.9),
+    ('Vinegar', 0.4, 0, 0, 2, 2, 6, 0, 0, 0, 0),
+    ('Waffle', 5, 3, 70, 500, 130, 200, 2.3, 0, 0.3, 0.2),
+    ('Walnut Oil', 0, 9.1, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Walnuts', 2.6, 6.1, 0, 2, 441, 98, 2.9, 1.3, 0, 0),
+    ('Wasabi', 0, 0, 0, 17, 568, 128, 1, 41.9, 0, 0),
+    ('Water Chestnut', 4.8, 0.03, 0, 14, 584, 11, 0.1, 4, 0, 0),
+    ('Watercress', 0.2, 0, 0, 41, 330, 120, 0.2, 43, 0, 0),
+    ('Watermelon', 6.2, 0.02, 0, 1, 112, 7, 0.2, 8.1, 0, 0),
+    ('Weisswurst', 0.5, 9, 70, 800, 220, 20, 1, 0, 0.3, 1),
+    ('Wheat Bran', 0.4, 0.6, 0, 2, 1182, 73, 10.6, 0, 0, 0),
+    ('Wheat Flour', 0.3, 0.2, 0, 2, 107, 15, 1.2, 0, 0, 0),
+    ('Wheat Germ', 0, 1.7, 0, 12, 892, 39, 6.3, 0, 0, 0),
+    ('Whelk', 0, 0.1, 65, 206, 350, 57, 5, 0, 0, 9),
+    ('White Beans', 0.3, 0.1, 0, 6, 561, 90, 3.7, 0, 0, 0),
+    ('White Bread', 5, 0.7, 0, 490, 115, 150, 3.6, 0, 0, 0),
+    ('White Chocolate', 59, 19.4, 21, 90, 286, 199, 0.2, 0.5, 0, 0.6),
+    ('White Rice', 0.1, 0.08, 0, 1, 35, 10, 0.2, 0, 0, 0),
+    ('White Sapote', 15, 0.02, 0, 5, 200, 20, 0.5, 20, 0, 0),
+    ('Whole Milk', 4.8, 1.9, 10, 43, 132, 113, 0, 0, 1.3, 0.5),
+    ('Whole Wheat Bread', 5.6, 0.9, 0, 450, 250, 160, 2.5, 0, 0, 0),
+    ('Wild Boar', 0, 1.2, 70, 60, 350, 12, 1.1, 0, 0, 0.6),
+    ('Wild Rice', 2.5, 0.2, 0, 7, 427, 21, 2, 0, 0, 0),
+    ('Worcestershire Sauce', 10, 0, 0, 980, 800, 107, 5.3, 13, 0, 0),
+    ('Xylitol', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Yam', 0.5, 0.04, 0, 9, 816, 17, 0.5, 17.1, 0, 0),
+    ('Yeast', 0, 1, 0, 51, 955, 30, 2.2, 0.3, 0, 0),
+    ('Yellow Lentils', 1.8, 0.05, 0, 2, 369, 19, 3.3, 1.5, 0, 0),
+    ('Yogurt', 3.2, 0.1, 5, 36, 141, 110, 0.1, 0, 0, 0.8),
+    ('Zucchini', 2.5, 0.08, 0, 8, 261, 16, 0.4, 17.9, 0, 0);
+
+INSERT INTO ingredient_nutrients (ingredient_name, nutrient_key, amount)
+SELECT i.NAME, n.key,
+    CASE n.key
+        WHEN 'sugars' THEN s.sugars
+        WHEN 'saturated_fat' THEN s.saturated_fat
+        WHEN 'cholesterol' THEN s.cholesterol
+        WHEN 'sodium' THEN s.sodium
+        WHEN 'potassium' THEN s.potassium
+        WHEN 'calcium' THEN s.calcium
+        WHEN 'iron' THEN s.iron
+        WHEN 'vitamin_c' THEN s.vitamin_c
+        WHEN 'vitamin_d' THEN s.vitamin_d
+        WHEN 'vitamin_b12' THEN s.vitamin_b12
+    END
+FROM seed_nutrients s
+JOIN ingredients i ON i.NAME = s.name
+JOIN nutrients n ON n.key IN ('sugars', 'saturated_fat', 'cholesterol', 'sodium', 'potassium', 'calcium', 'iron', 'vitamin_c', 'vitamin_d', 'vitamin_b12');
+
+DROP TABLE seed_nutrients;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ingredient_portions;
+ALTER TABLE ingredients DROP COLUMN DENSITY;
@@ -0,0 +1,192 @@
+ALTER TABLE ingredients ADD COLUMN DENSITY REAL;
+
+CREATE TABLE ingredient_portions (
+    ingredient_name TEXT NOT NULL COLLATE NOCASE,
+    unit TEXT NOT NULL,
+    grams REAL NOT NULL CHECK (grams > 0),
+    PRIMARY KEY (ingredient_name, unit)
+);
+
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Milk';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Whole Milk';
+UPDATE ingredients SET DENSITY = 1.035 WHERE NAME = 'Skim Milk';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Buttermilk';
+UPDATE ingredients SET DENSITY = 1.01 WHERE NAME = 'Almond Milk';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Soy Milk';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Oat Milk';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Rice Milk';
+UPDATE ingredients SET DENSITY = 0.97 WHERE NAME = 'Coconut Milk';
+UPDATE ingredients SET DENSITY = 0.99 WHERE NAME = 'Heavy Cream';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Sour Cream';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Yogurt';
+UPDATE ingredients SET DENSITY = 1.05 WHERE NAME = 'Greek Yogurt';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Kefir';
+UPDATE ingredients SET DENSITY = 0.91 WHERE NAME = 'Olive Oil';
+UPDATE ingredients SET DENSITY = 0.92 WHERE NAME = 'Vegetable Oil';
+UPDATE ingredients SET DENSITY = 0.92 WHERE NAME = 'Sunflower Oil';
+UPDATE ingredients SET DENSITY = 0.92 WHERE NAME = 'Canola Oil';
+UPDATE ingredients SET DENSITY = 0.92 WHERE NAME = 'Rapeseed Oil';
+UPDATE ingredients SET DENSITY = 0.92 WHERE NAME = 'Coconut Oil';
+UPDATE ingredients SET DENSITY = 0.92 WHERE NAME = 'Sesame Oil';
+UPDATE ingredients SET DENSITY = 0.91 WHERE NAME = 'Avocado Oil';
+UPDATE ingredients SET DENSITY = 0.91 WHERE NAME = 'Peanut Oil';
+UPDATE ingredients SET DENSITY = 0.92 WHERE NAME = 'Corn Oil';
+UPDATE ingredients SET DENSITY = 0.911 WHERE NAME = 'Butter';
+UPDATE ingredients SET DENSITY = 0.91 WHERE NAME = 'Ghee';
+UPDATE ingredients SET DENSITY = 1.42 WHERE NAME = 'Honey';
+UPDATE ingredients SET DENSITY = 1.32 WHERE NAME = 'Maple Syrup';
+UPDATE ingredients SET DENSITY = 1.36 WHERE NAME = 'Agave Syrup';
+UPDATE ingredients SET DENSITY = 1.4 WHERE NAME = 'Molasses';
+UPDATE ingredients SET DENSITY = 1.38 WHERE NAME = 'Corn Syrup';
+UPDATE ingredients SET DENSITY = 1.4 WHERE NAME = 'Golden Syrup';
+UPDATE ingredients SET DENSITY = 0.85 WHERE NAME = 'Sugar';
+UPDATE ingredients SET DENSITY = 0.83 WHERE NAME = 'Brown Sugar';
+UPDATE ingredients SET DENSITY = 0.56 WHERE NAME = 'Powdered Sugar';
+UPDATE ingredients SET DENSITY = 0.56 WHERE NAME = 'Icing Sugar';
+UPDATE ingredients SET DENSITY = 0.8 WHERE NAME = 'Coconut Sugar';
+UPDATE ingredients SET DENSITY = 1.2 WHERE NAME = 'Salt';
+UPDATE ingredients SET DENSITY = 0.53 WHERE NAME = 'All-Purpose Flour';
+UPDATE ingredients SET DENSITY = 0.53 WHERE NAME = 'Wheat Flour';
+UPDATE ingredients SET DENSITY = 0.55 WHERE NAME = 'Bread Flour';
+UPDATE ingredients SET DENSITY = 0.48 WHERE NAME = 'Cake Flour';
+UPDATE ingredients SET DENSITY = 0.5 WHERE NAME = 'Pastry Flour';
+UPDATE ingredients SET DENSITY = 0.53 WHERE NAME = 'Self-Rising Flour';
+UPDATE ingredients SET DENSITY = 0.4 WHERE NAME = 'Almond Flour';
+UPDATE ingredients SET DENSITY = 0.48 WHERE NAME = 'Coconut Flour';
+UPDATE ingredients SET DENSITY = 0.66 WHERE NAME = 'Rice Flour';
+UPDATE ingredients SET DENSITY = 0.42 WHERE NAME = 'Oat Flour';
+UPDATE ingredients SET DENSITY = 0.66 WHERE NAME = 'Cornmeal';
+UPDATE ingredients SET DENSITY = 0.54 WHERE NAME = 'Cornstarch';
+UPDATE ingredients SET DENSITY = 0.45 WHERE NAME = 'Cocoa Powder';
+UPDATE ingredients SET DENSITY = 0.9 WHERE NAME = 'Baking Powder';
+UPDATE ingredients SET DENSITY = 0.93 WHERE NAME = 'Baking Soda';
+UPDATE ingredients SET DENSITY = 0.45 WHERE NAME = 'Breadcrumbs';
+UPDATE ingredients SET DENSITY = 0.35 WHERE NAME = 'Oats';
+UPDATE ingredients SET DENSITY = 0.85 WHERE NAME = 'White Rice';
+UPDATE ingredients SET DENSITY = 0.8 WHERE NAME = 'Brown Rice';
+UPDATE ingredients SET DENSITY = 0.8 WHERE NAME = 'Basmati Rice';
+UPDATE ingredients SET DENSITY = 0.8 WHERE NAME = 'Jasmine Rice';
+UPDATE ingredients SET DENSITY = 0.72 WHERE NAME = 'Quinoa';
+UPDATE ingredients SET DENSITY = 0.73 WHERE NAME = 'Couscous';
+UPDATE ingredients SET DENSITY = 0.6 WHERE NAME = 'Bulgur';
+UPDATE ingredients SET DENSITY = 0.8 WHERE NAME = 'Lentils';
+UPDATE ingredients SET DENSITY = 0.8 WHERE NAME = 'Red Lentils';
+UPDATE ingredients SET DENSITY = 0.65 WHERE NAME = 'Chia Seeds';
+UPDATE ingredients SET DENSITY = 0.55 WHERE NAME = 'Flax Seeds';
+UPDATE ingredients SET DENSITY = 0.6 WHERE NAME = 'Sesame Seeds';
+UPDATE ingredients SET DENSITY = 0.55 WHERE NAME = 'Sunflower Seeds';
+UPDATE ingredients SET DENSITY = 0.55 WHERE NAME = 'Pumpkin Seeds';
+UPDATE ingredients SET DENSITY = 0.6 WHERE NAME = 'Almonds';
+UPDATE ingredients SET DENSITY = 0.5 WHERE NAME = 'Walnuts';
+UPDATE ingredients SET DENSITY = 0.6 WHERE NAME = 'Peanuts';
+UPDATE ingredients SET DENSITY = 1.15 WHERE NAME = 'Soy Sauce';
+UPDATE ingredients SET DENSITY = 1.01 WHERE NAME = 'Vinegar';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Lemon Juice';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Lime Juice';
+UPDATE ingredients SET DENSITY = 1.04 WHERE NAME = 'Orange Juice';
+UPDATE ingredients SET DENSITY = 1.04 WHERE NAME = 'Apple Cider';
+UPDATE ingredients SET DENSITY = 1.15 WHERE NAME = 'Ketchup';
+UPDATE ingredients SET DENSITY = 0.91 WHERE NAME = 'Mayonnaise';
+UPDATE ingredients SET DENSITY = 1.05 WHERE NAME = 'Mustard';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Tomato Sauce';
+UPDATE ingredients SET DENSITY = 1.03 WHERE NAME = 'Marinara Sauce';
+UPDATE ingredients SET DENSITY = 1.09 WHERE NAME = 'Peanut Butter';
+UPDATE ingredients SET DENSITY = 1.09 WHERE NAME = 'Almond Butter';
+UPDATE ingredients SET DENSITY = 1.06 WHERE NAME = 'Tahini';
+UPDATE ingredients SET DENSITY = 1.05 WHERE NAME = 'Hummus';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Chicken Broth';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Beef Broth';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Vegetable Broth';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Chicken Stock';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Beef Stock';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Vegetable Stock';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Tea';
+UPDATE ingredients SET DENSITY = 1.0 WHERE NAME = 'Coffee';
+UPDATE ingredients SET DENSITY = 0.56 WHERE NAME = 'Cinnamon';
+UPDATE ingredients SET DENSITY = 0.5 WHERE NAME = 'Black Pepper';
+UPDATE ingredients SET DENSITY = 0.6 WHERE NAME = 'Turmeric';
+UPDATE ingredients SET DENSITY = 0.6 WHERE NAME = 'Green Peas';
+UPDATE ingredients SET DENSITY = 0.7 WHERE NAME = 'Sweet Corn';
+UPDATE ingredients SET DENSITY = 0.6 WHERE NAME = 'Blueberry';
+UPDATE ingredients SET DENSITY = 0.65 WHERE NAME = 'Raisin';
+
+INSERT INTO ingredient_portions (ingredient_name, unit, grams)
+SELECT p.ingredient_name, p.unit, p.grams
+FROM (
+    SELECT 'Egg' AS ingredient_name, 'piece' AS unit, 50 AS grams
+    UNION ALL SELECT 'Apple', 'piece', 182
+    UNION ALL SELECT 'Banana', 'piece', 118
+    UNION ALL SELECT 'Orange', 'piece', 131
+    UNION ALL SELECT 'Pear', 'piece', 178
+    UNION ALL SELECT 'Peach', 'piece', 150
+    UNION ALL SELECT 'Plum', 'piece', 66
+    UNION ALL SELECT 'Kiwi', 'piece', 69
+    UNION ALL SELECT 'Mango', 'piece', 336
+    UNION ALL SELECT 'Avocado', 'piece', 150
+    UNION ALL SELECT 'Clementine', 'piece', 74
+    UNION ALL SELECT 'Tangerine', 'piece', 88
+    UNION ALL SELECT 'Strawberry', 'piece', 12
+    UNION ALL SELECT 'Date', 'piece', 7
+    UNION ALL SELECT 'Apricot', 'piece', 35
+    UNION ALL SELECT 'Fig', 'piece', 50
+    UNION ALL SELECT 'Garlic', 'clove', 5
+    UNION ALL SELECT 'Garlic', 'piece', 40
+    UNION ALL SELECT 'Onion', 'piece', 110
+    UNION ALL SELECT 'Shallot', 'piece', 25
+    UNION ALL SELECT 'Tomato', 'piece', 123
+    UNION ALL SELECT 'Potato', 'piece', 173
+    UNION ALL SELECT 'Sweet Potato', 'piece', 130
+    UNION ALL SELECT 'Carrot', 'piece', 61
+    UNION ALL SELECT 'Bell Pepper', 'piece', 119
+    UNION ALL SELECT 'Cucumber', 'piece', 300
+    UNION ALL SELECT 'Zucchini', 'piece', 196
+    UNION ALL SELECT 'Eggplant', 'piece', 458
+    UNION ALL SELECT 'Mushroom', 'piece', 18
+    UNION ALL SELECT 'Green Onion', 'piece', 15
+    UNION ALL SELECT 'Scallion', 'piece', 15
+    UNION ALL SELECT 'Spring Onion', 'piece', 15
+    UNION ALL SELECT 'Jalapeno', 'piece', 14
+    UNION ALL SELECT 'Lettuce', 'piece', 360
+    UNION ALL SELECT 'Cabbage', 'piece', 900
+    UNION ALL SELECT 'Broccoli', 'bunch', 600
+    UNION ALL SELECT 'Parsley', 'bunch', 60
+    UNION ALL SELECT 'Coriander', 'bunch', 60
+    UNION ALL SELECT 'Basil', 'bunch', 30
+    UNION ALL SELECT 'White Bread', 'slice', 25
+    UNION ALL SELECT 'Whole Wheat Bread', 'slice', 32
+    UNION ALL SELECT 'Multigrain Bread', 'slice', 26
+    UNION ALL SELECT 'Rye Bread', 'slice', 32
+    UNION ALL SELECT 'Sourdough Bread', 'slice', 50
+    UNION ALL SELECT 'Bacon', 'slice', 8
+    UNION ALL SELECT 'Cheddar Cheese', 'slice', 28
+    UNION ALL SELECT 'Swiss Cheese', 'slice', 28
+    UNION ALL SELECT 'Mozzarella', 'slice', 28
+    UNION ALL SELECT 'Pork Ham', 'slice', 28
+    UNION ALL SELECT 'Bagel', 'piece', 105
+    UNION ALL SELECT 'Croissant', 'piece', 57
+    UNION ALL SELECT 'Muffin', 'piece', 113
+    UNION ALL SELECT 'Pancake', 'piece', 77
+    UNION ALL SELECT 'Waffle', 'piece', 75
+    UNION ALL SELECT 'Tortilla', 'piece', 49
+    UNION ALL SELECT 'Flour Tortilla', 'piece', 45
+    UNION ALL SELECT 'Corn Tortilla', 'piece', 26
+    UNION ALL SELECT 'Pita Bread', 'piece', 60
+    UNION ALL SELECT 'Naan', 'piece', 90
+    UNION ALL SELECT 'English Muffin', 'piece', 57
+    UNION ALL SELECT 'Chicken Breast', 'piece', 174
+    UNION ALL SELECT 'Chicken Thigh', 'piece', 110
+    UNION ALL SELECT 'Chicken Drumstick', 'piece', 95
+    UNION ALL SELECT 'Chicken Wing', 'piece', 34
+    UNION ALL SELECT 'Pork Chop', 'piece', 150
+    UNION ALL SELECT 'Lamb Chop', 'piece', 90
+    UNION ALL SELECT 'Black Beans', 'can', 240
+    UNION ALL SELECT 'Chickpeas', 'can', 240
+    UNION ALL SELECT 'Kidney Beans', 'can', 240
+    UNION ALL SELECT 'Cannellini Beans', 'can', 240
+    UNION ALL SELECT 'Tuna', 'can', 140
+    UNION ALL SELECT 'Sardines', 'can', 92
+    UNION ALL SELECT 'Coconut Milk', 'can', 400
+    UNION ALL SELECT 'Tomato Sauce', 'can', 425
+    UNION ALL SELECT 'Sweet Corn', 'can', 340
+) p
+WHERE EXISTS (SELECT 1 FROM ingredients i WHERE i.NAME = p.ingredient_name);
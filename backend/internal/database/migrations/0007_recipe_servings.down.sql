@@ -0,0 +1,2 @@
+ALTER TABLE recipes DROP COLUMN yield_grams;
+ALTER TABLE recipes DROP COLUMN servings;
@@ -0,0 +1,2 @@
+ALTER TABLE recipes ADD COLUMN servings INTEGER NOT NULL DEFAULT 1 CHECK (servings > 0);
+ALTER TABLE recipes ADD COLUMN yield_grams REAL CHECK (yield_grams IS NULL OR yield_grams > 0);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS diary_entry_ingredients;
+DROP TABLE IF EXISTS diary_entries;
@@ -0,0 +1,21 @@
+CREATE TABLE diary_entries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id TEXT NOT NULL,
+    entry_date TEXT NOT NULL,
+    meal TEXT NOT NULL CHECK (meal IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    recipe_id INTEGER,
+    recipe_name TEXT,
+    portion REAL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_diary_entries_session_date ON diary_entries (session_id, entry_date);
+
+CREATE TABLE diary_entry_ingredients (
+    entry_id INTEGER NOT NULL REFERENCES diary_entries(id),
+    position INTEGER NOT NULL,
+    ingredient_name TEXT NOT NULL,
+    grams REAL NOT NULL,
+    data TEXT NOT NULL,
+    PRIMARY KEY (entry_id, position)
+);
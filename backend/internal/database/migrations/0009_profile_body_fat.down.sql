@@ -0,0 +1,2 @@
+ALTER TABLE user_profile_history DROP COLUMN body_fat;
+ALTER TABLE user_profiles DROP COLUMN body_fat;
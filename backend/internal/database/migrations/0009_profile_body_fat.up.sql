@@ -0,0 +1,2 @@
+ALTER TABLE user_profiles ADD COLUMN body_fat REAL NOT NULL DEFAULT 0;
+ALTER TABLE user_profile_history ADD COLUMN body_fat REAL NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE user_profiles ADD COLUMN allergies TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE user_profile_history ADD COLUMN allergies TEXT NOT NULL DEFAULT '[]';
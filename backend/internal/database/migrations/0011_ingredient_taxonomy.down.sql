@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ingredient_allergens;
+DROP TABLE IF EXISTS ingredient_metadata;
+DROP TABLE IF EXISTS allergens;
@@ -0,0 +1,1235 @@
+CREATE TABLE allergens (
+    key TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    eu14 INTEGER NOT NULL DEFAULT 0,
+    us9 INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO allergens (key, name, eu14, us9) VALUES
+    ('gluten', 'Cereals containing gluten', 1, 0),
+    ('wheat', 'Wheat', 0, 1),
+    ('crustaceans', 'Crustaceans', 1, 1),
+    ('molluscs', 'Molluscs', 1, 0),
+    ('eggs', 'Eggs', 1, 1),
+    ('fish', 'Fish', 1, 1),
+    ('peanuts', 'Peanuts', 1, 1),
+    ('soybeans', 'Soybeans', 1, 1),
+    ('milk', 'Milk', 1, 1),
+    ('tree_nuts', 'Tree nuts', 1, 1),
+    ('celery', 'Celery', 1, 0),
+    ('mustard', 'Mustard', 1, 0),
+    ('sesame', 'Sesame', 1, 1),
+    ('sulphites', 'Sulphur dioxide and sulphites', 1, 0),
+    ('lupin', 'Lupin', 1, 0);
+
+CREATE TABLE ingredient_metadata (
+    ingredient_name TEXT PRIMARY KEY COLLATE NOCASE,
+    food_group TEXT NOT NULL DEFAULT '',
+    animal_derived INTEGER NOT NULL DEFAULT 0,
+    contains_meat INTEGER NOT NULL DEFAULT 0,
+    contains_gluten INTEGER NOT NULL DEFAULT 0,
+    contains_lactose INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE ingredient_allergens (
+    ingredient_name TEXT NOT NULL COLLATE NOCASE,
+    allergen_key TEXT NOT NULL REFERENCES allergens(key),
+    PRIMARY KEY (ingredient_name, allergen_key)
+);
+
+INSERT INTO ingredient_metadata (ingredient_name, food_group, animal_derived, contains_meat, contains_gluten, contains_lactose) VALUES
+    ('00 Flour', 'grains', 0, 0, 1, 0),
+    ('Abalone', 'shellfish', 1, 1, 0, 0),
+    ('Ackee', 'fruits', 0, 0, 0, 0),
+    ('Acorn Squash', 'vegetables', 0, 0, 0, 0),
+    ('Adzuki Beans', 'legumes', 0, 0, 0, 0),
+    ('Agave Syrup', 'sweeteners', 0, 0, 0, 0),
+    ('Alfredo Sauce', 'condiments_sauces', 1, 0, 0, 1),
+    ('All-Purpose Flour', 'grains', 0, 0, 1, 0),
+    ('Almond Butter', 'nuts_seeds', 0, 0, 0, 0),
+    ('Almond Flour', 'grains', 0, 0, 0, 0),
+    ('Almond Milk', 'beverages', 0, 0, 0, 0),
+    ('Almonds', 'nuts_seeds', 0, 0, 0, 0),
+    ('Amaranth', 'grains', 0, 0, 0, 0),
+    ('Amaranth Leaves', 'vegetables', 0, 0, 0, 0),
+    ('Anaheim Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Anchovy', 'fish', 1, 1, 0, 0),
+    ('Andouille', 'meat', 1, 1, 0, 0),
+    ('Apple', 'fruits', 0, 0, 0, 0),
+    ('Apple Cider', 'beverages', 0, 0, 0, 0),
+    ('Apple Pie', 'sweets', 1, 0, 1, 1),
+    ('Apple Sauce', 'condiments_sauces', 0, 0, 0, 0),
+    ('Apricot', 'fruits', 0, 0, 0, 0),
+    ('Arborio Rice', 'grains', 0, 0, 0, 0),
+    ('Arepa', 'bread_bakery', 0, 0, 0, 0),
+    ('Arrowroot', 'grains', 0, 0, 0, 0),
+    ('Arrowroot Flour', 'grains', 0, 0, 0, 0),
+    ('Artichoke', 'vegetables', 0, 0, 0, 0),
+    ('Arugula', 'vegetables', 0, 0, 0, 0),
+    ('Ash Gourd', 'vegetables', 0, 0, 0, 0),
+    ('Asparagus', 'vegetables', 0, 0, 0, 0),
+    ('Aspartame', 'sweeteners', 0, 0, 0, 0),
+    ('Avocado', 'fruits', 0, 0, 0, 0),
+    ('Avocado Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Açaí', 'fruits', 0, 0, 0, 0),
+    ('Bacaba', 'fruits', 0, 0, 0, 0),
+    ('Bacon', 'meat', 1, 1, 0, 0),
+    ('Bagel', 'bread_bakery', 0, 0, 1, 0),
+    ('Baguette', 'bread_bakery', 0, 0, 1, 0),
+    ('Baking Powder', 'herbs_spices', 0, 0, 0, 0),
+    ('Baking Soda', 'herbs_spices', 0, 0, 0, 0),
+    ('Baklava', 'sweets', 1, 0, 1, 1),
+    ('Bamboo Shoots', 'vegetables', 0, 0, 0, 0),
+    ('Banana', 'fruits', 0, 0, 0, 0),
+    ('Baobab Fruit', 'fruits', 0, 0, 0, 0),
+    ('Barbecue Sauce', 'condiments_sauces', 0, 0, 0, 0),
+    ('Barberry', 'fruits', 0, 0, 0, 0),
+    ('Barley', 'grains', 0, 0, 1, 0),
+    ('Barley Flour', 'grains', 0, 0, 1, 0),
+    ('Basil', 'herbs_spices', 0, 0, 0, 0),
+    ('Basmati Rice', 'grains', 0, 0, 0, 0),
+    ('Beech Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Beef (lean)', 'meat', 1, 1, 0, 0),
+    ('Beef Brisket', 'meat', 1, 1, 0, 0),
+    ('Beef Broth', 'broths_stocks', 1, 1, 1, 0),
+    ('Beef Chuck', 'meat', 1, 1, 0, 0),
+    ('Beef Jerky', 'meat', 1, 1, 0, 0),
+    ('Beef Kidney', 'meat', 1, 1, 0, 0),
+    ('Beef Liver', 'meat', 1, 1, 0, 0),
+    ('Beef Ribeye', 'meat', 1, 1, 0, 0),
+    ('Beef Sirloin', 'meat', 1, 1, 0, 0),
+    ('Beef Stock', 'broths_stocks', 1, 1, 0, 0),
+    ('Beef Tallow', 'fats_oils', 1, 1, 0, 0),
+    ('Beef Tenderloin', 'meat', 1, 1, 0, 0),
+    ('Beef Tongue', 'meat', 1, 1, 0, 0),
+    ('Beet Greens', 'vegetables', 0, 0, 0, 0),
+    ('Beetroot', 'vegetables', 0, 0, 0, 0),
+    ('Bell Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Beluga Lentils', 'legumes', 0, 0, 0, 0),
+    ('Bilberry', 'fruits', 0, 0, 0, 0),
+    ('Biscuit', 'bread_bakery', 1, 0, 1, 1),
+    ('Bison', 'meat', 1, 1, 0, 0),
+    ('Bitter Melon', 'vegetables', 0, 0, 0, 0),
+    ('Black Beans', 'legumes', 0, 0, 0, 0),
+    ('Black Pepper', 'herbs_spices', 0, 0, 0, 0),
+    ('Black Rice', 'grains', 0, 0, 0, 0),
+    ('Black Sapote', 'fruits', 0, 0, 0, 0),
+    ('Black-eyed Peas', 'legumes', 0, 0, 0, 0),
+    ('Blended Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Blood Orange', 'fruits', 0, 0, 0, 0),
+    ('Blood Sausage', 'meat', 1, 1, 0, 0),
+    ('Blue Cheese', 'dairy', 1, 0, 0, 1),
+    ('Blueberry', 'fruits', 0, 0, 0, 0),
+    ('Bockwurst', 'meat', 1, 1, 0, 0),
+    ('Bok Choy', 'vegetables', 0, 0, 0, 0),
+    ('Bone Broth', 'broths_stocks', 1, 1, 0, 0),
+    ('Borlotti Beans', 'legumes', 0, 0, 0, 0),
+    ('Bottle Gourd', 'vegetables', 0, 0, 0, 0),
+    ('Boysenberry', 'fruits', 0, 0, 0, 0),
+    ('Bratwurst', 'meat', 1, 1, 1, 0),
+    ('Brazil Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Bread Flour', 'grains', 0, 0, 1, 0),
+    ('Breadcrumbs', 'bread_bakery', 0, 0, 1, 0),
+    ('Breadfruit', 'fruits', 0, 0, 0, 0),
+    ('Breadnut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Breadstick', 'bread_bakery', 0, 0, 1, 0),
+    ('Bresaola', 'meat', 1, 1, 0, 0),
+    ('Brie Cheese', 'dairy', 1, 0, 0, 1),
+    ('Brioche', 'bread_bakery', 1, 0, 1, 1),
+    ('Broccoli', 'vegetables', 0, 0, 0, 0),
+    ('Broccolini', 'vegetables', 0, 0, 0, 0),
+    ('Brown Rice', 'grains', 0, 0, 0, 0),
+    ('Brown Sugar', 'sweeteners', 0, 0, 0, 0),
+    ('Brownie', 'sweets', 1, 0, 1, 1),
+    ('Brussels Sprouts', 'vegetables', 0, 0, 0, 0),
+    ('Buckwheat', 'grains', 0, 0, 0, 0),
+    ('Buckwheat Flour', 'grains', 0, 0, 0, 0),
+    ('Bulgur', 'grains', 0, 0, 1, 0),
+    ('Burdock Root', 'vegetables', 0, 0, 0, 0),
+    ('Butter', 'dairy', 1, 0, 0, 1),
+    ('Buttermilk', 'dairy', 1, 0, 0, 1),
+    ('Butternut Squash', 'vegetables', 0, 0, 0, 0),
+    ('Butterscotch', 'sweets', 1, 0, 0, 1),
+    ('Cabbage', 'vegetables', 0, 0, 0, 0),
+    ('Cake Flour', 'grains', 0, 0, 1, 0),
+    ('Calamondin', 'fruits', 0, 0, 0, 0),
+    ('Camelina Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Camembert Cheese', 'dairy', 1, 0, 0, 1),
+    ('Camu Camu', 'fruits', 0, 0, 0, 0),
+    ('Candied Fruit', 'sweets', 0, 0, 0, 0),
+    ('Candy Cane', 'sweets', 0, 0, 0, 0),
+    ('Canistel', 'fruits', 0, 0, 0, 0),
+    ('Cannellini Beans', 'legumes', 0, 0, 0, 0),
+    ('Canola Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Carambola', 'fruits', 0, 0, 0, 0),
+    ('Caramel', 'sweets', 1, 0, 0, 1),
+    ('Carrot', 'vegetables', 0, 0, 0, 0),
+    ('Carrot Cake', 'sweets', 1, 0, 1, 1),
+    ('Cashew Butter', 'nuts_seeds', 0, 0, 0, 0),
+    ('Cashews', 'nuts_seeds', 0, 0, 0, 0),
+    ('Cassava', 'vegetables', 0, 0, 0, 0),
+    ('Catfish', 'fish', 1, 1, 0, 0),
+    ('Cauliflower', 'vegetables', 0, 0, 0, 0),
+    ('Caviar', 'fish', 1, 1, 0, 0),
+    ('Celeriac', 'vegetables', 0, 0, 0, 0),
+    ('Celery', 'vegetables', 0, 0, 0, 0),
+    ('Chapati', 'bread_bakery', 0, 0, 1, 0),
+    ('Chayote', 'vegetables', 0, 0, 0, 0),
+    ('Chayote Squash', 'vegetables', 0, 0, 0, 0),
+    ('Cheddar Cheese', 'dairy', 1, 0, 0, 1),
+    ('Cheesecake', 'sweets', 1, 0, 1, 1),
+    ('Cherimoya', 'fruits', 0, 0, 0, 0),
+    ('Cherry', 'fruits', 0, 0, 0, 0),
+    ('Chestnut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Chestnut Flour', 'grains', 0, 0, 0, 0),
+    ('Chewing Gum', 'sweets', 0, 0, 0, 0),
+    ('Chia Seeds', 'nuts_seeds', 0, 0, 0, 0),
+    ('Chicken Breast', 'poultry', 1, 1, 0, 0),
+    ('Chicken Broth', 'broths_stocks', 1, 1, 0, 0),
+    ('Chicken Drumstick', 'poultry', 1, 1, 0, 0),
+    ('Chicken Fat', 'fats_oils', 1, 1, 0, 0),
+    ('Chicken Gizzard', 'poultry', 1, 1, 0, 0),
+    ('Chicken Heart', 'poultry', 1, 1, 0, 0),
+    ('Chicken Liver', 'poultry', 1, 1, 0, 0),
+    ('Chicken Nugget', 'poultry', 1, 1, 1, 0),
+    ('Chicken Patty', 'poultry', 1, 1, 1, 0),
+    ('Chicken Stock', 'broths_stocks', 1, 1, 0, 0),
+    ('Chicken Thigh', 'poultry', 1, 1, 0, 0),
+    ('Chicken Wing', 'poultry', 1, 1, 0, 0),
+    ('Chickpea Flour', 'grains', 0, 0, 0, 0),
+    ('Chickpeas', 'legumes', 0, 0, 0, 0),
+    ('Chicory', 'vegetables', 0, 0, 0, 0),
+    ('Chinese Cabbage', 'vegetables', 0, 0, 0, 0),
+    ('Chives', 'herbs_spices', 0, 0, 0, 0),
+    ('Chocolate Bar', 'sweets', 1, 0, 0, 1),
+    ('Chocolate Chip Cookie', 'sweets', 1, 0, 1, 1),
+    ('Chocolate Eclair', 'sweets', 1, 0, 1, 1),
+    ('Chocolate Fudge', 'sweets', 1, 0, 0, 1),
+    ('Chocolate Spread', 'sweets', 1, 0, 0, 1),
+    ('Chocolate Truffle', 'sweets', 1, 0, 0, 1),
+    ('Chokeberry', 'fruits', 0, 0, 0, 0),
+    ('Chorizo', 'meat', 1, 1, 0, 0),
+    ('Ciabatta', 'bread_bakery', 0, 0, 1, 0),
+    ('Cinnamon', 'herbs_spices', 0, 0, 0, 0),
+    ('Clam', 'shellfish', 1, 1, 0, 0),
+    ('Clarified Butter', 'dairy', 1, 0, 0, 0),
+    ('Clementine', 'fruits', 0, 0, 0, 0),
+    ('Clotted Cream', 'dairy', 1, 0, 0, 1),
+    ('Cloudberry', 'fruits', 0, 0, 0, 0),
+    ('Cockle', 'shellfish', 1, 1, 0, 0),
+    ('Cocoa Powder', 'herbs_spices', 0, 0, 0, 0),
+    ('Coconut Flour', 'grains', 0, 0, 0, 0),
+    ('Coconut Milk', 'beverages', 0, 0, 0, 0),
+    ('Coconut Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Coconut Sugar', 'sweeteners', 0, 0, 0, 0),
+    ('Cod', 'fish', 1, 1, 0, 0),
+    ('Cod Liver Oil', 'fats_oils', 1, 1, 0, 0),
+    ('Coffee', 'beverages', 0, 0, 0, 0),
+    ('Collard Greens', 'vegetables', 0, 0, 0, 0),
+    ('Condensed Milk', 'dairy', 1, 0, 0, 1),
+    ('Coriander', 'herbs_spices', 0, 0, 0, 0),
+    ('Corn', 'vegetables', 0, 0, 0, 0),
+    ('Corn Bran', 'grains', 0, 0, 0, 0),
+    ('Corn Flakes', 'grains', 0, 0, 1, 0),
+    ('Corn Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Corn Syrup', 'sweeteners', 0, 0, 0, 0),
+    ('Corn Tortilla', 'bread_bakery', 0, 0, 0, 0),
+    ('Cornbread', 'bread_bakery', 1, 0, 1, 1),
+    ('Corned Beef', 'meat', 1, 1, 0, 0),
+    ('Cornish Hen', 'poultry', 1, 1, 0, 0),
+    ('Cornmeal', 'grains', 0, 0, 0, 0),
+    ('Cornstarch', 'grains', 0, 0, 0, 0),
+    ('Cottage Cheese', 'dairy', 1, 0, 0, 1),
+    ('Cottonseed Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Couscous', 'grains', 0, 0, 1, 0),
+    ('Crab', 'shellfish', 1, 1, 0, 0),
+    ('Cracker', 'bread_bakery', 0, 0, 1, 0),
+    ('Cranberry', 'fruits', 0, 0, 0, 0),
+    ('Cranberry Sauce', 'condiments_sauces', 0, 0, 0, 0),
+    ('Cream Cheese', 'dairy', 1, 0, 0, 1),
+    ('Cream of Wheat', 'grains', 0, 0, 1, 0),
+    ('Cress', 'vegetables', 0, 0, 0, 0),
+    ('Croissant', 'bread_bakery', 1, 0, 1, 1),
+    ('Crumpet', 'bread_bakery', 1, 0, 1, 1),
+    ('Crème Fraîche', 'dairy', 1, 0, 0, 1),
+    ('Cucumber', 'vegetables', 0, 0, 0, 0),
+    ('Cupuassu', 'fruits', 0, 0, 0, 0),
+    ('Cupuaçu', 'fruits', 0, 0, 0, 0),
+    ('Currant', 'fruits', 0, 0, 0, 0),
+    ('Curry Paste', 'condiments_sauces', 0, 0, 0, 0),
+    ('Cuttlefish', 'shellfish', 1, 1, 0, 0),
+    ('Daikon', 'vegetables', 0, 0, 0, 0),
+    ('Daikon Radish', 'vegetables', 0, 0, 0, 0),
+    ('Damson', 'fruits', 0, 0, 0, 0),
+    ('Dandelion Greens', 'vegetables', 0, 0, 0, 0),
+    ('Danish Pastry', 'sweets', 1, 0, 1, 1),
+    ('Dark Chocolate', 'sweets', 0, 0, 0, 0),
+    ('Dashi', 'broths_stocks', 1, 1, 0, 0),
+    ('Date', 'fruits', 0, 0, 0, 0),
+    ('Date Syrup', 'sweeteners', 0, 0, 0, 0),
+    ('Delicata Squash', 'vegetables', 0, 0, 0, 0),
+    ('Dill', 'herbs_spices', 0, 0, 0, 0),
+    ('Dill Weed', 'herbs_spices', 0, 0, 0, 0),
+    ('Dragon Fruit', 'fruits', 0, 0, 0, 0),
+    ('Duck', 'poultry', 1, 1, 0, 0),
+    ('Duck Breast', 'poultry', 1, 1, 0, 0),
+    ('Duck Confit', 'poultry', 1, 1, 0, 0),
+    ('Duck Drippings', 'fats_oils', 1, 1, 0, 0),
+    ('Duck Fat', 'fats_oils', 1, 1, 0, 0),
+    ('Duck Leg', 'poultry', 1, 1, 0, 0),
+    ('Duck Liver', 'poultry', 1, 1, 0, 0),
+    ('Durum Wheat Flour', 'grains', 0, 0, 1, 0),
+    ('Edamame', 'legumes', 0, 0, 0, 0),
+    ('Eel', 'fish', 1, 1, 0, 0),
+    ('Egg', 'eggs', 1, 0, 0, 0),
+    ('Egg Noodles', 'grains', 1, 0, 1, 0),
+    ('Eggplant', 'vegetables', 0, 0, 0, 0),
+    ('Einkorn', 'grains', 0, 0, 1, 0),
+    ('Elderberry', 'fruits', 0, 0, 0, 0),
+    ('Elk', 'meat', 1, 1, 0, 0),
+    ('Emmental Cheese', 'dairy', 1, 0, 0, 1),
+    ('Emmer', 'grains', 0, 0, 1, 0),
+    ('Endive', 'vegetables', 0, 0, 0, 0),
+    ('English Muffin', 'bread_bakery', 0, 0, 1, 0),
+    ('Erythritol', 'sweeteners', 0, 0, 0, 0),
+    ('Escarole', 'vegetables', 0, 0, 0, 0),
+    ('Evaporated Milk', 'dairy', 1, 0, 0, 1),
+    ('Farina', 'grains', 0, 0, 1, 0),
+    ('Farro', 'grains', 0, 0, 1, 0),
+    ('Fava Beans', 'legumes', 0, 0, 0, 0),
+    ('Feijoa', 'fruits', 0, 0, 0, 0),
+    ('Fennel', 'vegetables', 0, 0, 0, 0),
+    ('Feta Cheese', 'dairy', 1, 0, 0, 1),
+    ('Fiddlehead Fern', 'vegetables', 0, 0, 0, 0),
+    ('Fig', 'fruits', 0, 0, 0, 0),
+    ('Fish Oil', 'fats_oils', 1, 1, 0, 0),
+    ('Fish Stock', 'broths_stocks', 1, 1, 0, 0),
+    ('Flatbread', 'bread_bakery', 0, 0, 1, 0),
+    ('Flax Seeds', 'nuts_seeds', 0, 0, 0, 0),
+    ('Flaxseed Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Flounder', 'fish', 1, 1, 0, 0),
+    ('Flour Tortilla', 'bread_bakery', 0, 0, 1, 0),
+    ('Focaccia', 'bread_bakery', 0, 0, 1, 0),
+    ('Fondant', 'sweets', 0, 0, 0, 0),
+    ('Fonio', 'grains', 0, 0, 0, 0),
+    ('Frankfurter', 'meat', 1, 1, 1, 0),
+    ('Freekeh', 'grains', 0, 0, 1, 0),
+    ('French Lentils', 'legumes', 0, 0, 0, 0),
+    ('Fruit Chews', 'sweets', 1, 1, 0, 0),
+    ('Fruit Drops', 'sweets', 0, 0, 0, 0),
+    ('Fruit Gums', 'sweets', 1, 1, 0, 0),
+    ('Fruit Jam', 'sweets', 0, 0, 0, 0),
+    ('Fruit Jelly', 'sweets', 0, 0, 0, 0),
+    ('Fruit Leather', 'sweets', 0, 0, 0, 0),
+    ('Fruit Pastilles', 'sweets', 0, 0, 0, 0),
+    ('Fudge', 'sweets', 1, 0, 0, 1),
+    ('Game Hen', 'poultry', 1, 1, 0, 0),
+    ('Garlic', 'vegetables', 0, 0, 0, 0),
+    ('Gelatin', 'sweets', 1, 1, 0, 0),
+    ('Ghee', 'dairy', 1, 0, 0, 0),
+    ('Ghost Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Ginger', 'herbs_spices', 0, 0, 0, 0),
+    ('Gingerbread', 'sweets', 1, 0, 1, 1),
+    ('Ginkgo Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Glass Noodles', 'grains', 0, 0, 0, 0),
+    ('Gluten-Free Flour', 'grains', 0, 0, 0, 0),
+    ('Goat Cheese', 'dairy', 1, 0, 0, 1),
+    ('Goat Meat', 'meat', 1, 1, 0, 0),
+    ('Goat Milk', 'dairy', 1, 0, 0, 1),
+    ('Goji Berry', 'fruits', 0, 0, 0, 0),
+    ('Golden Syrup', 'sweeteners', 0, 0, 0, 0),
+    ('Goldenberry', 'fruits', 0, 0, 0, 0),
+    ('Goose', 'poultry', 1, 1, 0, 0),
+    ('Goose Fat', 'fats_oils', 1, 1, 0, 0),
+    ('Gooseberry', 'fruits', 0, 0, 0, 0),
+    ('Gouda Cheese', 'dairy', 1, 0, 0, 1),
+    ('Granola', 'grains', 0, 0, 1, 0),
+    ('Grapes', 'fruits', 0, 0, 0, 0),
+    ('Grapeseed Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Greek Yogurt', 'dairy', 1, 0, 0, 1),
+    ('Green Beans', 'vegetables', 0, 0, 0, 0),
+    ('Green Lentils', 'legumes', 0, 0, 0, 0),
+    ('Green Onion', 'vegetables', 0, 0, 0, 0),
+    ('Green Peas', 'vegetables', 0, 0, 0, 0),
+    ('Greengage', 'fruits', 0, 0, 0, 0),
+    ('Grits', 'grains', 0, 0, 0, 0),
+    ('Grouper', 'fish', 1, 1, 0, 0),
+    ('Guava', 'fruits', 0, 0, 0, 0),
+    ('Guinea Fowl', 'poultry', 1, 1, 0, 0),
+    ('Gummy Bears', 'sweets', 1, 1, 0, 0),
+    ('Habanero Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Haddock', 'fish', 1, 1, 0, 0),
+    ('Halibut', 'fish', 1, 1, 0, 0),
+    ('Halloumi', 'dairy', 1, 0, 0, 1),
+    ('Halva', 'sweets', 0, 0, 0, 0),
+    ('Hard Candy', 'sweets', 0, 0, 0, 0),
+    ('Hawthorn Berry', 'fruits', 0, 0, 0, 0),
+    ('Hazelnut Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Hazelnut Spread', 'sweets', 1, 0, 0, 1),
+    ('Hazelnuts', 'nuts_seeds', 0, 0, 0, 0),
+    ('Heavy Cream', 'dairy', 1, 0, 0, 1),
+    ('Hemp Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Herring', 'fish', 1, 1, 0, 0),
+    ('Hickory Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Hominy', 'grains', 0, 0, 0, 0),
+    ('Honey', 'sweeteners', 1, 0, 0, 0),
+    ('Honeycomb', 'sweets', 1, 0, 0, 0),
+    ('Horse Gram', 'legumes', 0, 0, 0, 0),
+    ('Horse Meat', 'meat', 1, 1, 0, 0),
+    ('Horseradish', 'herbs_spices', 0, 0, 0, 0),
+    ('Hot Sauce', 'condiments_sauces', 0, 0, 0, 0),
+    ('Huckleberry', 'fruits', 0, 0, 0, 0),
+    ('Hummus', 'legumes', 0, 0, 0, 0),
+    ('Icing Sugar', 'sweeteners', 0, 0, 0, 0),
+    ('Injera', 'bread_bakery', 0, 0, 0, 0),
+    ('Ivy Gourd', 'vegetables', 0, 0, 0, 0),
+    ('Jabuticaba', 'fruits', 0, 0, 0, 0),
+    ('Jackfruit', 'fruits', 0, 0, 0, 0),
+    ('Jalapeno', 'vegetables', 0, 0, 0, 0),
+    ('Jasmine Rice', 'grains', 0, 0, 0, 0),
+    ('Jelly Beans', 'sweets', 1, 1, 0, 0),
+    ('Jerusalem Artichoke', 'vegetables', 0, 0, 0, 0),
+    ('Jicama', 'vegetables', 0, 0, 0, 0),
+    ('Kabocha Squash', 'vegetables', 0, 0, 0, 0),
+    ('Kale', 'vegetables', 0, 0, 0, 0),
+    ('Kamut', 'grains', 0, 0, 1, 0),
+    ('Kamut Flour', 'grains', 0, 0, 1, 0),
+    ('Kangaroo', 'meat', 1, 1, 0, 0),
+    ('Kefir', 'dairy', 1, 0, 0, 1),
+    ('Ketchup', 'condiments_sauces', 0, 0, 0, 0),
+    ('Kidney Beans', 'legumes', 0, 0, 0, 0),
+    ('Kiwi', 'fruits', 0, 0, 0, 0),
+    ('Knackwurst', 'meat', 1, 1, 0, 0),
+    ('Kohlrabi', 'vegetables', 0, 0, 0, 0),
+    ('Komatsuna', 'vegetables', 0, 0, 0, 0),
+    ('Lamb Chop', 'meat', 1, 1, 0, 0),
+    ('Lamb Leg', 'meat', 1, 1, 0, 0),
+    ('Lamb Liver', 'meat', 1, 1, 0, 0),
+    ('Lamb Shank', 'meat', 1, 1, 0, 0),
+    ('Lamb Shoulder', 'meat', 1, 1, 0, 0),
+    ('Langoustine', 'shellfish', 1, 1, 0, 0),
+    ('Langsat', 'fruits', 0, 0, 0, 0),
+    ('Lard', 'fats_oils', 1, 1, 0, 0),
+    ('Lavash', 'bread_bakery', 0, 0, 1, 0),
+    ('Leek', 'vegetables', 0, 0, 0, 0),
+    ('Lemon Juice', 'beverages', 0, 0, 0, 0),
+    ('Lentils', 'legumes', 0, 0, 0, 0),
+    ('Lettuce', 'vegetables', 0, 0, 0, 0),
+    ('Licorice', 'sweets', 0, 0, 1, 0),
+    ('Lima Beans', 'legumes', 0, 0, 0, 0),
+    ('Lime Juice', 'beverages', 0, 0, 0, 0),
+    ('Lingonberry', 'fruits', 0, 0, 0, 0),
+    ('Liverwurst', 'meat', 1, 1, 0, 0),
+    ('Lobster', 'shellfish', 1, 1, 0, 0),
+    ('Loganberry', 'fruits', 0, 0, 0, 0),
+    ('Lollipop', 'sweets', 0, 0, 0, 0),
+    ('Longan', 'fruits', 0, 0, 0, 0),
+    ('Loquat', 'fruits', 0, 0, 0, 0),
+    ('Lotus Root', 'vegetables', 0, 0, 0, 0),
+    ('Lotus Seed', 'nuts_seeds', 0, 0, 0, 0),
+    ('Lovage', 'herbs_spices', 0, 0, 0, 0),
+    ('Lychee', 'fruits', 0, 0, 0, 0),
+    ('Macadamia Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Macadamia Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Macaron', 'sweets', 1, 0, 0, 1),
+    ('Mackerel', 'fish', 1, 1, 0, 0),
+    ('Malabar Spinach', 'vegetables', 0, 0, 0, 0),
+    ('Malt Syrup', 'sweeteners', 0, 0, 1, 0),
+    ('Mamey Sapote', 'fruits', 0, 0, 0, 0),
+    ('Manchego Cheese', 'dairy', 1, 0, 0, 1),
+    ('Mango', 'fruits', 0, 0, 0, 0),
+    ('Mangosteen', 'fruits', 0, 0, 0, 0),
+    ('Maple Candy', 'sweets', 0, 0, 0, 0),
+    ('Maple Syrup', 'sweeteners', 0, 0, 0, 0),
+    ('Maqui Berry', 'fruits', 0, 0, 0, 0),
+    ('Margarine', 'fats_oils', 0, 0, 0, 0),
+    ('Marinara Sauce', 'condiments_sauces', 0, 0, 0, 0),
+    ('Marionberry', 'fruits', 0, 0, 0, 0),
+    ('Marmalade', 'sweets', 0, 0, 0, 0),
+    ('Marshmallow', 'sweets', 1, 1, 0, 0),
+    ('Marshmallow Fluff', 'sweets', 1, 1, 0, 0),
+    ('Marzipan', 'sweets', 0, 0, 0, 0),
+    ('Mascarpone', 'dairy', 1, 0, 0, 1),
+    ('Matzo', 'bread_bakery', 0, 0, 1, 0),
+    ('Mayonnaise', 'condiments_sauces', 1, 0, 0, 0),
+    ('Medlar', 'fruits', 0, 0, 0, 0),
+    ('Meringue', 'sweets', 1, 0, 0, 0),
+    ('Milk', 'dairy', 1, 0, 0, 1),
+    ('Milk Chocolate', 'sweets', 1, 0, 0, 1),
+    ('Milk Toffee', 'sweets', 1, 0, 0, 1),
+    ('Millet', 'grains', 0, 0, 0, 0),
+    ('Millet Flour', 'grains', 0, 0, 0, 0),
+    ('Mint', 'herbs_spices', 0, 0, 0, 0),
+    ('Mirabelle Plum', 'fruits', 0, 0, 0, 0),
+    ('Miso Paste', 'legumes', 0, 0, 1, 0),
+    ('Mizuna', 'vegetables', 0, 0, 0, 0),
+    ('Molasses', 'sweeteners', 0, 0, 0, 0),
+    ('Monkfish', 'fish', 1, 1, 0, 0),
+    ('Mortadella', 'meat', 1, 1, 0, 0),
+    ('Mozzarella', 'dairy', 1, 0, 0, 1),
+    ('Mozzarella Cheese', 'dairy', 1, 0, 0, 1),
+    ('Muesli', 'grains', 0, 0, 1, 0),
+    ('Muffin', 'bread_bakery', 1, 0, 1, 1),
+    ('Mulberry', 'fruits', 0, 0, 0, 0),
+    ('Multigrain Bread', 'bread_bakery', 0, 0, 1, 0),
+    ('Mung Beans', 'legumes', 0, 0, 0, 0),
+    ('Mushroom', 'vegetables', 0, 0, 0, 0),
+    ('Mushroom Broth', 'broths_stocks', 0, 0, 0, 0),
+    ('Mussel', 'shellfish', 1, 1, 0, 0),
+    ('Mustard', 'condiments_sauces', 0, 0, 0, 0),
+    ('Mustard Greens', 'vegetables', 0, 0, 0, 0),
+    ('Mustard Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Mutton', 'meat', 1, 1, 0, 0),
+    ('Naan', 'bread_bakery', 1, 0, 1, 1),
+    ('Napa Cabbage', 'vegetables', 0, 0, 0, 0),
+    ('Navy Beans', 'legumes', 0, 0, 0, 0),
+    ('Nougat', 'sweets', 1, 0, 0, 0),
+    ('Nutella', 'sweets', 1, 0, 0, 1),
+    ('Oat Bran', 'grains', 0, 0, 1, 0),
+    ('Oat Flour', 'grains', 0, 0, 1, 0),
+    ('Oat Milk', 'beverages', 0, 0, 0, 0),
+    ('Oats', 'grains', 0, 0, 1, 0),
+    ('Octopus', 'shellfish', 1, 1, 0, 0),
+    ('Okra', 'vegetables', 0, 0, 0, 0),
+    ('Olive Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Onion', 'vegetables', 0, 0, 0, 0),
+    ('Orange', 'fruits', 0, 0, 0, 0),
+    ('Orange Juice', 'beverages', 0, 0, 0, 0),
+    ('Oregano', 'herbs_spices', 0, 0, 0, 0),
+    ('Ostrich', 'poultry', 1, 1, 0, 0),
+    ('Oyster', 'shellfish', 1, 1, 0, 0),
+    ('Padron Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Pak Choi', 'vegetables', 0, 0, 0, 0),
+    ('Palm Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Palm Sugar', 'sweeteners', 0, 0, 0, 0),
+    ('Pancake', 'bread_bakery', 1, 0, 1, 1),
+    ('Paneer', 'dairy', 1, 0, 0, 1),
+    ('Papaya', 'fruits', 0, 0, 0, 0),
+    ('Paratha', 'bread_bakery', 1, 0, 1, 1),
+    ('Parmesan Cheese', 'dairy', 1, 0, 0, 1),
+    ('Parsley', 'herbs_spices', 0, 0, 0, 0),
+    ('Parsley Root', 'vegetables', 0, 0, 0, 0),
+    ('Parsnip', 'vegetables', 0, 0, 0, 0),
+    ('Partridge', 'poultry', 1, 1, 0, 0),
+    ('Passion Fruit', 'fruits', 0, 0, 0, 0),
+    ('Pasta', 'grains', 0, 0, 1, 0),
+    ('Pastrami', 'meat', 1, 1, 0, 0),
+    ('Pastry Flour', 'grains', 0, 0, 1, 0),
+    ('Pattypan Squash', 'vegetables', 0, 0, 0, 0),
+    ('Pawpaw', 'fruits', 0, 0, 0, 0),
+    ('Pea Shoots', 'vegetables', 0, 0, 0, 0),
+    ('Peach', 'fruits', 0, 0, 0, 0),
+    ('Peanut Brittle', 'sweets', 0, 0, 0, 0),
+    ('Peanut Butter', 'nuts_seeds', 0, 0, 0, 0),
+    ('Peanut Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Peanuts', 'nuts_seeds', 0, 0, 0, 0),
+    ('Pear', 'fruits', 0, 0, 0, 0),
+    ('Peas', 'vegetables', 0, 0, 0, 0),
+    ('Pecan', 'nuts_seeds', 0, 0, 0, 0),
+    ('Pecan Pie', 'sweets', 1, 0, 1, 1),
+    ('Pepperoncini', 'vegetables', 0, 0, 0, 0),
+    ('Pepperoni', 'meat', 1, 1, 0, 0),
+    ('Pequi', 'fruits', 0, 0, 0, 0),
+    ('Perch', 'fish', 1, 1, 0, 0),
+    ('Persimmon', 'fruits', 0, 0, 0, 0),
+    ('Pesto', 'condiments_sauces', 1, 0, 0, 1),
+    ('Pheasant', 'poultry', 1, 1, 0, 0),
+    ('Pho Broth', 'broths_stocks', 1, 1, 1, 0),
+    ('Physalis', 'fruits', 0, 0, 0, 0),
+    ('Pickle Juice', 'beverages', 0, 0, 0, 0),
+    ('Pigeon', 'poultry', 1, 1, 0, 0),
+    ('Pigeon Peas', 'legumes', 0, 0, 0, 0),
+    ('Pili Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Pine Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Pineapple', 'fruits', 0, 0, 0, 0),
+    ('Pinto Beans', 'legumes', 0, 0, 0, 0),
+    ('Pistachio Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Pistachio Turkish Delight', 'sweets', 1, 1, 0, 0),
+    ('Pita Bread', 'bread_bakery', 0, 0, 1, 0),
+    ('Plaice', 'fish', 1, 1, 0, 0),
+    ('Plantain', 'fruits', 0, 0, 0, 0),
+    ('Plum', 'fruits', 0, 0, 0, 0),
+    ('Poblano Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Pointed Gourd', 'vegetables', 0, 0, 0, 0),
+    ('Polenta', 'grains', 0, 0, 0, 0),
+    ('Pollock', 'fish', 1, 1, 0, 0),
+    ('Pomegranate', 'fruits', 0, 0, 0, 0),
+    ('Pomelo', 'fruits', 0, 0, 0, 0),
+    ('Poppyseed Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Pork (lean)', 'meat', 1, 1, 0, 0),
+    ('Pork Belly', 'meat', 1, 1, 0, 0),
+    ('Pork Chop', 'meat', 1, 1, 0, 0),
+    ('Pork Ham', 'meat', 1, 1, 0, 0),
+    ('Pork Ribs', 'meat', 1, 1, 0, 0),
+    ('Pork Sausage', 'meat', 1, 1, 1, 0),
+    ('Pork Shoulder', 'meat', 1, 1, 0, 0),
+    ('Pork Tenderloin', 'meat', 1, 1, 0, 0),
+    ('Porridge', 'grains', 0, 0, 1, 0),
+    ('Potato', 'vegetables', 0, 0, 0, 0),
+    ('Potato Flour', 'grains', 0, 0, 0, 0),
+    ('Powdered Milk', 'dairy', 1, 0, 0, 1),
+    ('Powdered Sugar', 'sweeteners', 0, 0, 0, 0),
+    ('Pretzel', 'bread_bakery', 0, 0, 1, 0),
+    ('Profiterole', 'sweets', 1, 0, 1, 1),
+    ('Prosciutto', 'meat', 1, 1, 0, 0),
+    ('Provolone Cheese', 'dairy', 1, 0, 0, 1),
+    ('Prune', 'fruits', 0, 0, 0, 0),
+    ('Pumpernickel', 'bread_bakery', 0, 0, 1, 0),
+    ('Pumpernickel Bread', 'bread_bakery', 0, 0, 1, 0),
+    ('Pumpkin', 'vegetables', 0, 0, 0, 0),
+    ('Pumpkin Puree', 'condiments_sauces', 0, 0, 0, 0),
+    ('Pumpkin Seed Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Pumpkin Seeds', 'nuts_seeds', 0, 0, 0, 0),
+    ('Purslane', 'vegetables', 0, 0, 0, 0),
+    ('Quail', 'poultry', 1, 1, 0, 0),
+    ('Quark', 'dairy', 1, 0, 0, 1),
+    ('Queso Fresco', 'dairy', 1, 0, 0, 1),
+    ('Quince', 'fruits', 0, 0, 0, 0),
+    ('Quinoa', 'grains', 0, 0, 0, 0),
+    ('Quinoa Flour', 'grains', 0, 0, 0, 0),
+    ('Rabbit', 'meat', 1, 1, 0, 0),
+    ('Radicchio', 'vegetables', 0, 0, 0, 0),
+    ('Radish', 'vegetables', 0, 0, 0, 0),
+    ('Raisin', 'fruits', 0, 0, 0, 0),
+    ('Rambutan', 'fruits', 0, 0, 0, 0),
+    ('Ramen Broth', 'broths_stocks', 1, 1, 1, 0),
+    ('Ramen Noodles', 'grains', 0, 0, 1, 0),
+    ('Rapeseed Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Rapini', 'vegetables', 0, 0, 0, 0),
+    ('Razor Clam', 'shellfish', 1, 1, 0, 0),
+    ('Red Cabbage', 'vegetables', 0, 0, 0, 0),
+    ('Red Lentils', 'legumes', 0, 0, 0, 0),
+    ('Red Palm Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Red Rice', 'grains', 0, 0, 0, 0),
+    ('Relish', 'condiments_sauces', 0, 0, 0, 0),
+    ('Rice Bran', 'grains', 0, 0, 0, 0),
+    ('Rice Bran Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Rice Cake', 'bread_bakery', 0, 0, 0, 0),
+    ('Rice Flour', 'grains', 0, 0, 0, 0),
+    ('Rice Milk', 'beverages', 0, 0, 0, 0),
+    ('Rice Noodles', 'grains', 0, 0, 0, 0),
+    ('Rice Paper', 'grains', 0, 0, 0, 0),
+    ('Rice Syrup', 'sweeteners', 0, 0, 0, 0),
+    ('Ricotta Cheese', 'dairy', 1, 0, 0, 1),
+    ('Roast Beef', 'meat', 1, 1, 0, 0),
+    ('Rock Candy', 'sweets', 0, 0, 0, 0),
+    ('Rocky Road', 'sweets', 1, 1, 1, 1),
+    ('Roe', 'fish', 1, 1, 0, 0),
+    ('Romanesco', 'vegetables', 0, 0, 0, 0),
+    ('Romanian Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Rose Hip', 'fruits', 0, 0, 0, 0),
+    ('Rosemary', 'herbs_spices', 0, 0, 0, 0),
+    ('Rowan Berry', 'fruits', 0, 0, 0, 0),
+    ('Rutabaga', 'vegetables', 0, 0, 0, 0),
+    ('Rye', 'grains', 0, 0, 1, 0),
+    ('Rye Bread', 'bread_bakery', 0, 0, 1, 0),
+    ('Rye Flour', 'grains', 0, 0, 1, 0),
+    ('Safflower Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Sago', 'grains', 0, 0, 0, 0),
+    ('Sago Flour', 'grains', 0, 0, 0, 0),
+    ('Salak', 'fruits', 0, 0, 0, 0),
+    ('Salami', 'meat', 1, 1, 0, 0),
+    ('Salmon', 'fish', 1, 1, 0, 0),
+    ('Salmonberry', 'fruits', 0, 0, 0, 0),
+    ('Salsa', 'condiments_sauces', 0, 0, 0, 0),
+    ('Salsify', 'vegetables', 0, 0, 0, 0),
+    ('Salt', 'herbs_spices', 0, 0, 0, 0),
+    ('Samphire', 'vegetables', 0, 0, 0, 0),
+    ('Santol', 'fruits', 0, 0, 0, 0),
+    ('Sapote', 'fruits', 0, 0, 0, 0),
+    ('Sardines', 'fish', 1, 1, 0, 0),
+    ('Saskatoon Berry', 'fruits', 0, 0, 0, 0),
+    ('Satsuma', 'fruits', 0, 0, 0, 0),
+    ('Scallion', 'vegetables', 0, 0, 0, 0),
+    ('Scallop', 'shellfish', 1, 1, 0, 0),
+    ('Schmaltz', 'fats_oils', 1, 1, 0, 0),
+    ('Scone', 'bread_bakery', 1, 0, 1, 1),
+    ('Scotch Bonnet', 'vegetables', 0, 0, 0, 0),
+    ('Sea Asparagus', 'vegetables', 0, 0, 0, 0),
+    ('Sea Bass', 'fish', 1, 1, 0, 0),
+    ('Sea Buckthorn', 'fruits', 0, 0, 0, 0),
+    ('Sea Urchin', 'shellfish', 1, 1, 0, 0),
+    ('Seaweed', 'vegetables', 0, 0, 0, 0),
+    ('Seitan', 'grains', 0, 0, 1, 0),
+    ('Self-Rising Flour', 'grains', 0, 0, 1, 0),
+    ('Semolina', 'grains', 0, 0, 1, 0),
+    ('Semolina Flour', 'grains', 0, 0, 1, 0),
+    ('Serrano Pepper', 'vegetables', 0, 0, 0, 0),
+    ('Serviceberry', 'fruits', 0, 0, 0, 0),
+    ('Sesame Halva', 'sweets', 0, 0, 0, 0),
+    ('Sesame Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Sesame Paste (Tahini)', 'nuts_seeds', 0, 0, 0, 0),
+    ('Sesame Seeds', 'nuts_seeds', 0, 0, 0, 0),
+    ('Shallot', 'vegetables', 0, 0, 0, 0),
+    ('Sheep Milk', 'dairy', 1, 0, 0, 1),
+    ('Shirataki Noodles', 'grains', 0, 0, 0, 0),
+    ('Shortbread', 'sweets', 1, 0, 1, 1),
+    ('Shortening', 'fats_oils', 0, 0, 0, 0),
+    ('Shrimp', 'shellfish', 1, 1, 0, 0),
+    ('Skim Milk', 'dairy', 1, 0, 0, 1),
+    ('Snake Fruit', 'fruits', 0, 0, 0, 0),
+    ('Snake Gourd', 'vegetables', 0, 0, 0, 0),
+    ('Snapper', 'fish', 1, 1, 0, 0),
+    ('Snow Peas', 'vegetables', 0, 0, 0, 0),
+    ('Soba Noodles', 'grains', 0, 0, 1, 0),
+    ('Sole', 'fish', 1, 1, 0, 0),
+    ('Soppressata', 'meat', 1, 1, 0, 0),
+    ('Sorbitol', 'sweeteners', 0, 0, 0, 0),
+    ('Sorghum', 'grains', 0, 0, 0, 0),
+    ('Sorghum Flour', 'grains', 0, 0, 0, 0),
+    ('Sorrel', 'vegetables', 0, 0, 0, 0),
+    ('Sour Cream', 'dairy', 1, 0, 0, 1),
+    ('Sourdough Bread', 'bread_bakery', 0, 0, 1, 0),
+    ('Soursop', 'fruits', 0, 0, 0, 0),
+    ('Soy Flour', 'grains', 0, 0, 0, 0),
+    ('Soy Milk', 'beverages', 0, 0, 0, 0),
+    ('Soy Sauce', 'condiments_sauces', 0, 0, 1, 0),
+    ('Soybean Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Soybeans', 'legumes', 0, 0, 0, 0),
+    ('Spaghetti Squash', 'vegetables', 0, 0, 0, 0),
+    ('Spelt', 'grains', 0, 0, 1, 0),
+    ('Spelt Flour', 'grains', 0, 0, 1, 0),
+    ('Spinach', 'vegetables', 0, 0, 0, 0),
+    ('Split Peas', 'legumes', 0, 0, 0, 0),
+    ('Spring Onion', 'vegetables', 0, 0, 0, 0),
+    ('Squid', 'shellfish', 1, 1, 0, 0),
+    ('Sriracha', 'condiments_sauces', 0, 0, 0, 0),
+    ('Starfruit', 'fruits', 0, 0, 0, 0),
+    ('Stevia', 'sweeteners', 0, 0, 0, 0),
+    ('Sticky Rice', 'grains', 0, 0, 0, 0),
+    ('Strawberry', 'fruits', 0, 0, 0, 0),
+    ('Suckling Pig', 'meat', 1, 1, 0, 0),
+    ('Sucralose', 'sweeteners', 0, 0, 0, 0),
+    ('Sugar', 'sweeteners', 0, 0, 0, 0),
+    ('Sugar Apple', 'fruits', 0, 0, 0, 0),
+    ('Sugar Snap Peas', 'vegetables', 0, 0, 0, 0),
+    ('Sunflower Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Sunflower Seeds', 'nuts_seeds', 0, 0, 0, 0),
+    ('Sushi Rice', 'grains', 0, 0, 0, 0),
+    ('Sweet Corn', 'vegetables', 0, 0, 0, 0),
+    ('Sweet Potato', 'vegetables', 0, 0, 0, 0),
+    ('Sweetbreads', 'meat', 1, 1, 0, 0),
+    ('Swiss Chard', 'vegetables', 0, 0, 0, 0),
+    ('Swiss Cheese', 'dairy', 1, 0, 0, 1),
+    ('Swordfish', 'fish', 1, 1, 0, 0),
+    ('Tabasco', 'condiments_sauces', 0, 0, 0, 0),
+    ('Tahini', 'nuts_seeds', 0, 0, 0, 0),
+    ('Tamarind', 'fruits', 0, 0, 0, 0),
+    ('Tangerine', 'fruits', 0, 0, 0, 0),
+    ('Tapioca', 'grains', 0, 0, 0, 0),
+    ('Tapioca Flour', 'grains', 0, 0, 0, 0),
+    ('Taro', 'vegetables', 0, 0, 0, 0),
+    ('Tat Soi', 'vegetables', 0, 0, 0, 0),
+    ('Tayberry', 'fruits', 0, 0, 0, 0),
+    ('Tea', 'beverages', 0, 0, 0, 0),
+    ('Teff', 'grains', 0, 0, 0, 0),
+    ('Teff Flour', 'grains', 0, 0, 0, 0),
+    ('Tempeh', 'legumes', 0, 0, 0, 0),
+    ('Teriyaki Sauce', 'condiments_sauces', 0, 0, 1, 0),
+    ('Thyme', 'herbs_spices', 0, 0, 0, 0),
+    ('Tiger Nut', 'nuts_seeds', 0, 0, 0, 0),
+    ('Tilapia', 'fish', 1, 1, 0, 0),
+    ('Tinda', 'vegetables', 0, 0, 0, 0),
+    ('Toffee', 'sweets', 1, 0, 0, 1),
+    ('Tofu', 'legumes', 0, 0, 0, 0),
+    ('Tomatillo', 'vegetables', 0, 0, 0, 0),
+    ('Tomato', 'vegetables', 0, 0, 0, 0),
+    ('Tomato Sauce', 'condiments_sauces', 0, 0, 0, 0),
+    ('Tortilla', 'bread_bakery', 0, 0, 1, 0),
+    ('Tripe', 'meat', 1, 1, 0, 0),
+    ('Triticale', 'grains', 0, 0, 1, 0),
+    ('Triticale Flakes', 'grains', 0, 0, 1, 0),
+    ('Trout', 'fish', 1, 1, 0, 0),
+    ('Tuna', 'fish', 1, 1, 0, 0),
+    ('Turban Squash', 'vegetables', 0, 0, 0, 0),
+    ('Turbot', 'fish', 1, 1, 0, 0),
+    ('Turkey Bacon', 'poultry', 1, 1, 0, 0),
+    ('Turkey Breast', 'poultry', 1, 1, 0, 0),
+    ('Turkey Burger', 'poultry', 1, 1, 1, 0),
+    ('Turkey Leg', 'poultry', 1, 1, 0, 0),
+    ('Turkey Sausage', 'poultry', 1, 1, 0, 0),
+    ('Turkey Thigh', 'poultry', 1, 1, 0, 0),
+    ('Turkish Delight', 'sweets', 0, 0, 0, 0),
+    ('Turmeric', 'herbs_spices', 0, 0, 0, 0),
+    ('Turnip', 'vegetables', 0, 0, 0, 0),
+    ('Turnip Greens', 'vegetables', 0, 0, 0, 0),
+    ('Turron', 'sweets', 1, 0, 0, 0),
+    ('Udon Noodles', 'grains', 0, 0, 1, 0),
+    ('Ugli Fruit', 'fruits', 0, 0, 0, 0),
+    ('Veal', 'meat', 1, 1, 0, 0),
+    ('Vegetable Broth', 'broths_stocks', 0, 0, 0, 0),
+    ('Vegetable Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Vegetable Shortening', 'fats_oils', 0, 0, 0, 0),
+    ('Vegetable Stock', 'broths_stocks', 0, 0, 0, 0),
+    ('Venison', 'meat', 1, 1, 0, 0),
+    ('Vienna Sausage', 'meat', 1, 1, 0, 0),
+    ('Vinegar', 'condiments_sauces', 0, 0, 0, 0),
+    ('Waffle', 'bread_bakery', 1, 0, 1, 1),
+    ('Walnut Oil', 'fats_oils', 0, 0, 0, 0),
+    ('Walnuts', 'nuts_seeds', 0, 0, 0, 0),
+    ('Wasabi', 'condiments_sauces', 0, 0, 0, 0),
+    ('Water Chestnut', 'vegetables', 0, 0, 0, 0),
+    ('Watercress', 'vegetables', 0, 0, 0, 0),
+    ('Watermelon', 'fruits', 0, 0, 0, 0),
+    ('Weisswurst', 'meat', 1, 1, 0, 0),
+    ('Wheat Bran', 'grains', 0, 0, 1, 0),
+    ('Wheat Flour', 'grains', 0, 0, 1, 0),
+    ('Wheat Germ', 'grains', 0, 0, 1, 0),
+    ('Whelk', 'shellfish', 1, 1, 0, 0),
+    ('White Beans', 'legumes', 0, 0, 0, 0),
+    ('White Bread', 'bread_bakery', 0, 0, 1, 0),
+    ('White Chocolate', 'sweets', 1, 0, 0, 1),
+    ('White Rice', 'grains', 0, 0, 0, 0),
+    ('White Sapote', 'fruits', 0, 0, 0, 0),
+    ('Whole Milk', 'dairy', 1, 0, 0, 1),
+    ('Whole Wheat Bread', 'bread_bakery', 0, 0, 1, 0),
+    ('Wild Boar', 'meat', 1, 1, 0, 0),
+    ('Wild Rice', 'grains', 0, 0, 0, 0),
+    ('Worcestershire Sauce', 'condiments_sauces', 1, 1, 1, 0),
+    ('Xylitol', 'sweeteners', 0, 0, 0, 0),
+    ('Yam', 'vegetables', 0, 0, 0, 0),
+    ('Yeast', 'herbs_spices', 0, 0, 0, 0),
+    ('Yellow Lentils', 'legumes', 0, 0, 0, 0),
+    ('Yogurt', 'dairy', 1, 0, 0, 1),
+    ('Zucchini', 'vegetables', 0, 0, 0, 0),
+    ('Black Olives', 'vegetables', 0, 0, 0, 0),
+    ('Cherry Tomatoes', 'vegetables', 0, 0, 0, 0),
+    ('Falafel', 'legumes', 0, 0, 0, 0),
+    ('Lasagna Noodles', 'grains', 0, 0, 1, 0),
+    ('Lemon', 'fruits', 0, 0, 0, 0),
+    ('Pizza Dough', 'bread_bakery', 0, 0, 1, 0);
+
+INSERT INTO ingredient_allergens (ingredient_name, allergen_key) VALUES
+    ('00 Flour', 'gluten'),
+    ('00 Flour', 'wheat'),
+    ('Abalone', 'molluscs'),
+    ('Alfredo Sauce', 'eggs'),
+    ('Alfredo Sauce', 'milk'),
+    ('All-Purpose Flour', 'gluten'),
+    ('All-Purpose Flour', 'wheat'),
+    ('Almond Butter', 'tree_nuts'),
+    ('Almond Flour', 'tree_nuts'),
+    ('Almond Milk', 'tree_nuts'),
+    ('Almonds', 'tree_nuts'),
+    ('Anchovy', 'fish'),
+    ('Apple Cider', 'sulphites'),
+    ('Apple Pie', 'gluten'),
+    ('Apple Pie', 'milk'),
+    ('Apple Pie', 'wheat'),
+    ('Bagel', 'gluten'),
+    ('Bagel', 'wheat'),
+    ('Baguette', 'gluten'),
+    ('Baguette', 'wheat'),
+    ('Baklava', 'gluten'),
+    ('Baklava', 'milk'),
+    ('Baklava', 'tree_nuts'),
+    ('Baklava', 'wheat'),
+    ('Barley', 'gluten'),
+    ('Barley Flour', 'gluten'),
+    ('Beech Nut', 'tree_nuts'),
+    ('Beef Broth', 'celery'),
+    ('Beef Broth', 'gluten'),
+    ('Beef Broth', 'wheat'),
+    ('Beef Stock', 'celery'),
+    ('Biscuit', 'gluten'),
+    ('Biscuit', 'milk'),
+    ('Biscuit', 'wheat'),
+    ('Blue Cheese', 'milk'),
+    ('Bone Broth', 'celery'),
+    ('Bratwurst', 'gluten'),
+    ('Bratwurst', 'wheat'),
+    ('Brazil Nut', 'tree_nuts'),
+    ('Bread Flour', 'gluten'),
+    ('Bread Flour', 'wheat'),
+    ('Breadcrumbs', 'gluten'),
+    ('Breadcrumbs', 'wheat'),
+    ('Breadstick', 'gluten'),
+    ('Breadstick', 'wheat'),
+    ('Brie Cheese', 'milk'),
+    ('Brioche', 'eggs'),
+    ('Brioche', 'gluten'),
+    ('Brioche', 'milk'),
+    ('Brioche', 'wheat'),
+    ('Brownie', 'eggs'),
+    ('Brownie', 'gluten'),
+    ('Brownie', 'milk'),
+    ('Brownie', 'wheat'),
+    ('Bulgur', 'gluten'),
+    ('Bulgur', 'wheat'),
+    ('Butter', 'milk'),
+    ('Buttermilk', 'milk'),
+    ('Butterscotch', 'milk'),
+    ('Cake Flour', 'gluten'),
+    ('Cake Flour', 'wheat'),
+    ('Camembert Cheese', 'milk'),
+    ('Candied Fruit', 'sulphites'),
+    ('Caramel', 'milk'),
+    ('Carrot Cake', 'eggs'),
+    ('Carrot Cake', 'gluten'),
+    ('Carrot Cake', 'milk'),
+    ('Carrot Cake', 'wheat'),
+    ('Cashew Butter', 'tree_nuts'),
+    ('Cashews', 'tree_nuts'),
+    ('Catfish', 'fish'),
+    ('Caviar', 'fish'),
+    ('Celeriac', 'celery'),
+    ('Celery', 'celery'),
+    ('Chapati', 'gluten'),
+    ('Chapati', 'wheat'),
+    ('Cheddar Cheese', 'milk'),
+    ('Cheesecake', 'eggs'),
+    ('Cheesecake', 'gluten'),
+    ('Cheesecake', 'milk'),
+    ('Cheesecake', 'wheat'),
+    ('Chicken Broth', 'celery'),
+    ('Chicken Nugget', 'gluten'),
+    ('Chicken Nugget', 'wheat'),
+    ('Chicken Patty', 'gluten'),
+    ('Chicken Patty', 'wheat'),
+    ('Chicken Stock', 'celery'),
+    ('Chocolate Bar', 'milk'),
+    ('Chocolate Bar', 'soybeans'),
+    ('Chocolate Chip Cookie', 'eggs'),
+    ('Chocolate Chip Cookie', 'gluten'),
+    ('Chocolate Chip Cookie', 'milk'),
+    ('Chocolate Chip Cookie', 'wheat'),
+    ('Chocolate Eclair', 'eggs'),
+    ('Chocolate Eclair', 'gluten'),
+    ('Chocolate Eclair', 'milk'),
+    ('Chocolate Eclair', 'wheat'),
+    ('Chocolate Fudge', 'milk'),
+    ('Chocolate Spread', 'milk'),
+    ('Chocolate Truffle', 'milk'),
+    ('Ciabatta', 'gluten'),
+    ('Ciabatta', 'wheat'),
+    ('Clam', 'molluscs'),
+    ('Clarified Butter', 'milk'),
+    ('Clotted Cream', 'milk'),
+    ('Cockle', 'molluscs'),
+    ('Cod', 'fish'),
+    ('Cod Liver Oil', 'fish'),
+    ('Condensed Milk', 'milk'),
+    ('Corn Flakes', 'gluten'),
+    ('Cornbread', 'eggs'),
+    ('Cornbread', 'gluten'),
+    ('Cornbread', 'milk'),
+    ('Cornbread', 'wheat'),
+    ('Cottage Cheese', 'milk'),
+    ('Couscous', 'gluten'),
+    ('Couscous', 'wheat'),
+    ('Crab', 'crustaceans'),
+    ('Cracker', 'gluten'),
+    ('Cracker', 'wheat'),
+    ('Cream Cheese', 'milk'),
+    ('Cream of Wheat', 'gluten'),
+    ('Cream of Wheat', 'wheat'),
+    ('Croissant', 'gluten'),
+    ('Croissant', 'milk'),
+    ('Croissant', 'wheat'),
+    ('Crumpet', 'gluten'),
+    ('Crumpet', 'milk'),
+    ('Crumpet', 'wheat'),
+    ('Crème Fraîche', 'milk'),
+    ('Cuttlefish', 'molluscs'),
+    ('Danish Pastry', 'eggs'),
+    ('Danish Pastry', 'gluten'),
+    ('Danish Pastry', 'milk'),
+    ('Danish Pastry', 'wheat'),
+    ('Dark Chocolate', 'soybeans'),
+    ('Dashi', 'fish'),
+    ('Durum Wheat Flour', 'gluten'),
+    ('Durum Wheat Flour', 'wheat'),
+    ('Edamame', 'soybeans'),
+    ('Eel', 'fish'),
+    ('Egg', 'eggs'),
+    ('Egg Noodles', 'eggs'),
+    ('Egg Noodles', 'gluten'),
+    ('Egg Noodles', 'wheat'),
+    ('Einkorn', 'gluten'),
+    ('Einkorn', 'wheat'),
+    ('Emmental Cheese', 'milk'),
+    ('Emmer', 'gluten'),
+    ('Emmer', 'wheat'),
+    ('English Muffin', 'gluten'),
+    ('English Muffin', 'wheat'),
+    ('Evaporated Milk', 'milk'),
+    ('Farina', 'gluten'),
+    ('Farina', 'wheat'),
+    ('Farro', 'gluten'),
+    ('Farro', 'wheat'),
+    ('Feta Cheese', 'milk'),
+    ('Fish Oil', 'fish'),
+    ('Fish Stock', 'fish'),
+    ('Flatbread', 'gluten'),
+    ('Flatbread', 'wheat'),
+    ('Flounder', 'fish'),
+    ('Flour Tortilla', 'gluten'),
+    ('Flour Tortilla', 'wheat'),
+    ('Focaccia', 'gluten'),
+    ('Focaccia', 'wheat'),
+    ('Frankfurter', 'gluten'),
+    ('Frankfurter', 'wheat'),
+    ('Freekeh', 'gluten'),
+    ('Freekeh', 'wheat'),
+    ('Fudge', 'milk'),
+    ('Ghee', 'milk'),
+    ('Gingerbread', 'eggs'),
+    ('Gingerbread', 'gluten'),
+    ('Gingerbread', 'milk'),
+    ('Gingerbread', 'wheat'),
+    ('Goat Cheese', 'milk'),
+    ('Goat Milk', 'milk'),
+    ('Gouda Cheese', 'milk'),
+    ('Granola', 'gluten'),
+    ('Granola', 'wheat'),
+    ('Greek Yogurt', 'milk'),
+    ('Grouper', 'fish'),
+    ('Haddock', 'fish'),
+    ('Halibut', 'fish'),
+    ('Halloumi', 'milk'),
+    ('Halva', 'sesame'),
+    ('Hazelnut Oil', 'tree_nuts'),
+    ('Hazelnut Spread', 'milk'),
+    ('Hazelnut Spread', 'tree_nuts'),
+    ('Hazelnuts', 'tree_nuts'),
+    ('Heavy Cream', 'milk'),
+    ('Herring', 'fish'),
+    ('Hickory Nut', 'tree_nuts'),
+    ('Hummus', 'sesame'),
+    ('Kamut', 'gluten'),
+    ('Kamut', 'wheat'),
+    ('Kamut Flour', 'gluten'),
+    ('Kamut Flour', 'wheat'),
+    ('Kefir', 'milk'),
+    ('Langoustine', 'crustaceans'),
+    ('Lavash', 'gluten'),
+    ('Lavash', 'wheat'),
+    ('Licorice', 'gluten'),
+    ('Licorice', 'wheat'),
+    ('Lobster', 'crustaceans'),
+    ('Macadamia Nut', 'tree_nuts'),
+    ('Macadamia Oil', 'tree_nuts'),
+    ('Macaron', 'eggs'),
+    ('Macaron', 'milk'),
+    ('Macaron', 'tree_nuts'),
+    ('Mackerel', 'fish'),
+    ('Malt Syrup', 'gluten'),
+    ('Manchego Cheese', 'milk'),
+    ('Margarine', 'soybeans'),
+    ('Marshmallow', 'eggs'),
+    ('Marshmallow Fluff', 'eggs'),
+    ('Marzipan', 'tree_nuts'),
+    ('Mascarpone', 'milk'),
+    ('Matzo', 'gluten'),
+    ('Matzo', 'wheat'),
+    ('Mayonnaise', 'eggs'),
+    ('Meringue', 'eggs'),
+    ('Milk', 'milk'),
+    ('Milk Chocolate', 'milk'),
+    ('Milk Chocolate', 'soybeans'),
+    ('Milk Toffee', 'milk'),
+    ('Miso Paste', 'gluten'),
+    ('Miso Paste', 'soybeans'),
+    ('Miso Paste', 'wheat'),
+    ('Molasses', 'sulphites'),
+    ('Monkfish', 'fish'),
+    ('Mozzarella', 'milk'),
+    ('Mozzarella Cheese', 'milk'),
+    ('Muesli', 'gluten'),
+    ('Muesli', 'wheat'),
+    ('Muffin', 'eggs'),
+    ('Muffin', 'gluten'),
+    ('Muffin', 'milk'),
+    ('Muffin', 'wheat'),
+    ('Multigrain Bread', 'gluten'),
+    ('Multigrain Bread', 'wheat'),
+    ('Mussel', 'molluscs'),
+    ('Mustard', 'mustard'),
+    ('Mustard Greens', 'mustard'),
+    ('Mustard Oil', 'mustard'),
+    ('Naan', 'gluten'),
+    ('Naan', 'milk'),
+    ('Naan', 'wheat'),
+    ('Nougat', 'eggs'),
+    ('Nougat', 'tree_nuts'),
+    ('Nutella', 'milk'),
+    ('Nutella', 'tree_nuts'),
+    ('Oat Bran', 'gluten'),
+    ('Oat Flour', 'gluten'),
+    ('Oats', 'gluten'),
+    ('Octopus', 'molluscs'),
+    ('Oyster', 'molluscs'),
+    ('Pancake', 'eggs'),
+    ('Pancake', 'gluten'),
+    ('Pancake', 'milk'),
+    ('Pancake', 'wheat'),
+    ('Paneer', 'milk'),
+    ('Paratha', 'gluten'),
+    ('Paratha', 'milk'),
+    ('Paratha', 'wheat'),
+    ('Parmesan Cheese', 'milk'),
+    ('Pasta', 'gluten'),
+    ('Pasta', 'wheat'),
+    ('Pastry Flour', 'gluten'),
+    ('Pastry Flour', 'wheat'),
+    ('Peanut Brittle', 'peanuts'),
+    ('Peanut Butter', 'peanuts'),
+    ('Peanut Oil', 'peanuts'),
+    ('Peanuts', 'peanuts'),
+    ('Pecan', 'tree_nuts'),
+    ('Pecan Pie', 'eggs'),
+    ('Pecan Pie', 'gluten'),
+    ('Pecan Pie', 'milk'),
+    ('Pecan Pie', 'tree_nuts'),
+    ('Pecan Pie', 'wheat'),
+    ('Perch', 'fish'),
+    ('Pesto', 'milk'),
+    ('Pesto', 'tree_nuts'),
+    ('Pho Broth', 'gluten'),
+    ('Pho Broth', 'wheat'),
+    ('Pickle Juice', 'sulphites'),
+    ('Pili Nut', 'tree_nuts'),
+    ('Pistachio Oil', 'tree_nuts'),
+    ('Pistachio Turkish Delight', 'tree_nuts'),
+    ('Pita Bread', 'gluten'),
+    ('Pita Bread', 'wheat'),
+    ('Plaice', 'fish'),
+    ('Pollock', 'fish'),
+    ('Pork Sausage', 'gluten'),
+    ('Pork Sausage', 'wheat'),
+    ('Porridge', 'gluten'),
+    ('Powdered Milk', 'milk'),
+    ('Pretzel', 'gluten'),
+    ('Pretzel', 'wheat'),
+    ('Profiterole', 'eggs'),
+    ('Profiterole', 'gluten'),
+    ('Profiterole', 'milk'),
+    ('Profiterole', 'wheat'),
+    ('Provolone Cheese', 'milk'),
+    ('Prune', 'sulphites'),
+    ('Pumpernickel', 'gluten'),
+    ('Pumpernickel', 'wheat'),
+    ('Pumpernickel Bread', 'gluten'),
+    ('Pumpernickel Bread', 'wheat'),
+    ('Quark', 'milk'),
+    ('Queso Fresco', 'milk'),
+    ('Raisin', 'sulphites'),
+    ('Ramen Broth', 'gluten'),
+    ('Ramen Broth', 'wheat'),
+    ('Ramen Noodles', 'gluten'),
+    ('Ramen Noodles', 'wheat'),
+    ('Razor Clam', 'molluscs'),
+    ('Relish', 'sulphites'),
+    ('Ricotta Cheese', 'milk'),
+    ('Rocky Road', 'gluten'),
+    ('Rocky Road', 'milk'),
+    ('Rocky Road', 'tree_nuts'),
+    ('Rocky Road', 'wheat'),
+    ('Roe', 'fish'),
+    ('Rye', 'gluten'),
+    ('Rye Bread', 'gluten'),
+    ('Rye Bread', 'wheat'),
+    ('Rye Flour', 'gluten'),
+    ('Salmon', 'fish'),
+    ('Sardines', 'fish'),
+    ('Scallop', 'molluscs'),
+    ('Scone', 'eggs'),
+    ('Scone', 'gluten'),
+    ('Scone', 'milk'),
+    ('Scone', 'wheat'),
+    ('Sea Bass', 'fish'),
+    ('Sea Urchin', 'molluscs'),
+    ('Seitan', 'gluten'),
+    ('Seitan', 'wheat'),
+    ('Self-Rising Flour', 'gluten'),
+    ('Self-Rising Flour', 'wheat'),
+    ('Semolina', 'gluten'),
+    ('Semolina', 'wheat'),
+    ('Semolina Flour', 'gluten'),
+    ('Semolina Flour', 'wheat'),
+    ('Sesame Halva', 'sesame'),
+    ('Sesame Oil', 'sesame'),
+    ('Sesame Paste (Tahini)', 'sesame'),
+    ('Sesame Seeds', 'sesame'),
+    ('Sheep Milk', 'milk'),
+    ('Shortbread', 'gluten'),
+    ('Shortbread', 'milk'),
+    ('Shortbread', 'wheat'),
+    ('Shrimp', 'crustaceans'),
+    ('Skim Milk', 'milk'),
+    ('Snapper', 'fish'),
+    ('Soba Noodles', 'gluten'),
+    ('Soba Noodles', 'wheat'),
+    ('Sole', 'fish'),
+    ('Sour Cream', 'milk'),
+    ('Sourdough Bread', 'gluten'),
+    ('Sourdough Bread', 'wheat'),
+    ('Soy Flour', 'soybeans'),
+    ('Soy Milk', 'soybeans'),
+    ('Soy Sauce', 'gluten'),
+    ('Soy Sauce', 'soybeans'),
+    ('Soy Sauce', 'wheat'),
+    ('Soybean Oil', 'soybeans'),
+    ('Soybeans', 'soybeans'),
+    ('Spelt', 'gluten'),
+    ('Spelt', 'wheat'),
+    ('Spelt Flour', 'gluten'),
+    ('Spelt Flour', 'wheat'),
+    ('Squid', 'molluscs'),
+    ('Swiss Cheese', 'milk'),
+    ('Swordfish', 'fish'),
+    ('Tahini', 'sesame'),
+    ('Tempeh', 'soybeans'),
+    ('Teriyaki Sauce', 'gluten'),
+    ('Teriyaki Sauce', 'soybeans'),
+    ('Teriyaki Sauce', 'wheat'),
+    ('Tilapia', 'fish'),
+    ('Toffee', 'milk'),
+    ('Tofu', 'soybeans'),
+    ('Tortilla', 'gluten'),
+    ('Tortilla', 'wheat'),
+    ('Triticale', 'gluten'),
+    ('Triticale', 'wheat'),
+    ('Triticale Flakes', 'gluten'),
+    ('Triticale Flakes', 'wheat'),
+    ('Trout', 'fish'),
+    ('Tuna', 'fish'),
+    ('Turbot', 'fish'),
+    ('Turkey Burger', 'gluten'),
+    ('Turkey Burger', 'wheat'),
+    ('Turron', 'eggs'),
+    ('Turron', 'tree_nuts'),
+    ('Udon Noodles', 'gluten'),
+    ('Udon Noodles', 'wheat'),
+    ('Vegetable Broth', 'celery'),
+    ('Vegetable Stock', 'celery'),
+    ('Vinegar', 'sulphites'),
+    ('Waffle', 'eggs'),
+    ('Waffle', 'gluten'),
+    ('Waffle', 'milk'),
+    ('Waffle', 'wheat'),
+    ('Walnut Oil', 'tree_nuts'),
+    ('Walnuts', 'tree_nuts'),
+    ('Wheat Bran', 'gluten'),
+    ('Wheat Bran', 'wheat'),
+    ('Wheat Flour', 'gluten'),
+    ('Wheat Flour', 'wheat'),
+    ('Wheat Germ', 'gluten'),
+    ('Wheat Germ', 'wheat'),
+    ('Whelk', 'molluscs'),
+    ('White Bread', 'gluten'),
+    ('White Bread', 'wheat'),
+    ('White Chocolate', 'milk'),
+    ('White Chocolate', 'soybeans'),
+    ('Whole Milk', 'milk'),
+    ('Whole Wheat Bread', 'gluten'),
+    ('Whole Wheat Bread', 'wheat'),
+    ('Worcestershire Sauce', 'fish'),
+    ('Worcestershire Sauce', 'gluten'),
+    ('Worcestershire Sauce', 'sulphites'),
+    ('Worcestershire Sauce', 'wheat'),
+    ('Yogurt', 'milk'),
+    ('Lasagna Noodles', 'gluten'),
+    ('Lasagna Noodles', 'wheat'),
+    ('Pizza Dough', 'gluten'),
+    ('Pizza Dough', 'wheat');
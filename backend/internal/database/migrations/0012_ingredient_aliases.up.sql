@@ -0,0 +1,220 @@
+CREATE TABLE ingredient_aliases (
+    alias TEXT NOT NULL,
+    alias_key TEXT NOT NULL,
+    locale TEXT NOT NULL DEFAULT '',
+    ingredient_name TEXT NOT NULL COLLATE NOCASE,
+    preferred INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (alias_key, locale)
+);
+
+CREATE INDEX idx_ingredient_aliases_ingredient ON ingredient_aliases(ingredient_name, locale);
+
+INSERT INTO ingredient_aliases (alias, alias_key, locale, ingredient_name, preferred) VALUES
+    ('garbanzo beans', 'garbanzo beans', '', 'Chickpeas', 0),
+    ('garbanzo', 'garbanzo', '', 'Chickpeas', 0),
+    ('garbanzos', 'garbanzos', '', 'Chickpeas', 0),
+    ('scallion', 'scallion', '', 'Green Onion', 0),
+    ('scallions', 'scallions', '', 'Green Onion', 0),
+    ('maize', 'maize', '', 'Corn', 0),
+    ('capsicum', 'capsicum', '', 'Bell Pepper', 0),
+    ('sweet pepper', 'sweet pepper', '', 'Bell Pepper', 0),
+    ('Aubergine', 'aubergine', 'en-gb', 'Eggplant', 1),
+    ('Courgette', 'courgette', 'en-gb', 'Zucchini', 1),
+    ('Rocket', 'rocket', 'en-gb', 'Arugula', 1),
+    ('Swede', 'swede', 'en-gb', 'Rutabaga', 1),
+    ('Spring Onion', 'spring onion', 'en-gb', 'Green Onion', 1),
+    ('Prawns', 'prawns', 'en-gb', 'Shrimp', 1),
+    ('prawn', 'prawn', 'en-gb', 'Shrimp', 0),
+    ('Icing Sugar', 'icing sugar', 'en-gb', 'Powdered Sugar', 1),
+    ('Plain Flour', 'plain flour', 'en-gb', 'All-Purpose Flour', 1),
+    ('Double Cream', 'double cream', 'en-gb', 'Heavy Cream', 1),
+    ('Yoghurt', 'yoghurt', 'en-gb', 'Yogurt', 1),
+    ('Beef Mince', 'beef mince', 'en-gb', 'Beef (lean)', 1),
+    ('mince', 'mince', 'en-gb', 'Beef (lean)', 0),
+    ('caster sugar', 'caster sugar', 'en-gb', 'Sugar', 1),
+    ('Cilantro', 'cilantro', 'en-us', 'Coriander', 1),
+    ('Ground Beef', 'ground beef', 'en-us', 'Beef (lean)', 1),
+    ('Roșii', 'roșii', 'ro', 'Tomato', 1),
+    ('Rosii', 'rosii', 'ro', 'Tomato', 0),
+    ('roșie', 'roșie', 'ro', 'Tomato', 0),
+    ('rosie', 'rosie', 'ro', 'Tomato', 0),
+    ('Ceapă', 'ceapă', 'ro', 'Onion', 1),
+    ('Ceapa', 'ceapa', 'ro', 'Onion', 0),
+    ('Usturoi', 'usturoi', 'ro', 'Garlic', 1),
+    ('Cartofi', 'cartofi', 'ro', 'Potato', 1),
+    ('cartof', 'cartof', 'ro', 'Potato', 0),
+    ('Morcovi', 'morcovi', 'ro', 'Carrot', 1),
+    ('morcov', 'morcov', 'ro', 'Carrot', 0),
+    ('Vinete', 'vinete', 'ro', 'Eggplant', 1),
+    ('vânătă', 'vânătă', 'ro', 'Eggplant', 0),
+    ('vanata', 'vanata', 'ro', 'Eggplant', 0),
+    ('Dovlecei', 'dovlecei', 'ro', 'Zucchini', 1),
+    ('dovlecel', 'dovlecel', 'ro', 'Zucchini', 0),
+    ('Ardei gras', 'ardei gras', 'ro', 'Bell Pepper', 1),
+    ('ardei', 'ardei', 'ro', 'Bell Pepper', 0),
+    ('Lapte', 'lapte', 'ro', 'Milk', 1),
+    ('Ou', 'ou', 'ro', 'Egg', 1),
+    ('ouă', 'ouă', 'ro', 'Egg', 0),
+    ('oua', 'oua', 'ro', 'Egg', 0),
+    ('Unt', 'unt', 'ro', 'Butter', 1),
+    ('Făină albă', 'făină albă', 'ro', 'All-Purpose Flour', 1),
+    ('Faina alba', 'faina alba', 'ro', 'All-Purpose Flour', 0),
+    ('făină', 'făină', 'ro', 'All-Purpose Flour', 0),
+    ('faina', 'faina', 'ro', 'All-Purpose Flour', 0),
+    ('Zahăr', 'zahăr', 'ro', 'Sugar', 1),
+    ('Zahar', 'zahar', 'ro', 'Sugar', 0),
+    ('Orez alb', 'orez alb', 'ro', 'White Rice', 1),
+    ('orez', 'orez', 'ro', 'White Rice', 0),
+    ('Piept de pui', 'piept de pui', 'ro', 'Chicken Breast', 1),
+    ('Ulei de măsline', 'ulei de măsline', 'ro', 'Olive Oil', 1),
+    ('Ulei de masline', 'ulei de masline', 'ro', 'Olive Oil', 0),
+    ('Mere', 'mere', 'ro', 'Apple', 1),
+    ('măr', 'măr', 'ro', 'Apple', 0),
+    ('mar', 'mar', 'ro', 'Apple', 0),
+    ('Banane', 'banane', 'ro', 'Banana', 1),
+    ('banană', 'banană', 'ro', 'Banana', 0),
+    ('banana', 'banana', 'ro', 'Banana', 0),
+    ('Suc de lămâie', 'suc de lămâie', 'ro', 'Lemon Juice', 1),
+    ('Suc de lamaie', 'suc de lamaie', 'ro', 'Lemon Juice', 0),
+    ('Castraveți', 'castraveți', 'ro', 'Cucumber', 1),
+    ('Castraveti', 'castraveti', 'ro', 'Cucumber', 0),
+    ('castravete', 'castravete', 'ro', 'Cucumber', 0),
+    ('Spanac', 'spanac', 'ro', 'Spinach', 1),
+    ('Ciuperci', 'ciuperci', 'ro', 'Mushroom', 1),
+    ('ciupercă', 'ciupercă', 'ro', 'Mushroom', 0),
+    ('ciuperca', 'ciuperca', 'ro', 'Mushroom', 0),
+    ('Linte', 'linte', 'ro', 'Lentils', 1),
+    ('Năut', 'năut', 'ro', 'Chickpeas', 1),
+    ('Naut', 'naut', 'ro', 'Chickpeas', 0),
+    ('Iaurt', 'iaurt', 'ro', 'Yogurt', 1),
+    ('Smântână', 'smântână', 'ro', 'Sour Cream', 1),
+    ('Smantana', 'smantana', 'ro', 'Sour Cream', 0),
+    ('Miere', 'miere', 'ro', 'Honey', 1),
+    ('Sare', 'sare', 'ro', 'Salt', 1),
+    ('Piper negru', 'piper negru', 'ro', 'Black Pepper', 1),
+    ('piper', 'piper', 'ro', 'Black Pepper', 0),
+    ('Varză', 'varză', 'ro', 'Cabbage', 1),
+    ('Varza', 'varza', 'ro', 'Cabbage', 0),
+    ('Carne de vită', 'carne de vită', 'ro', 'Beef (lean)', 1),
+    ('Carne de vita', 'carne de vita', 'ro', 'Beef (lean)', 0),
+    ('Carne de porc', 'carne de porc', 'ro', 'Pork (lean)', 1),
+    ('Căpșuni', 'căpșuni', 'ro', 'Strawberry', 1),
+    ('Capsuni', 'capsuni', 'ro', 'Strawberry', 0),
+    ('căpșună', 'căpșună', 'ro', 'Strawberry', 0),
+    ('capsuna', 'capsuna', 'ro', 'Strawberry', 0),
+    ('Nuci', 'nuci', 'ro', 'Walnuts', 1),
+    ('Pătrunjel', 'pătrunjel', 'ro', 'Parsley', 1),
+    ('Patrunjel', 'patrunjel', 'ro', 'Parsley', 0),
+    ('Mărar', 'mărar', 'ro', 'Dill', 1),
+    ('Marar', 'marar', 'ro', 'Dill', 0),
+    ('Conopidă', 'conopidă', 'ro', 'Cauliflower', 1),
+    ('Conopida', 'conopida', 'ro', 'Cauliflower', 0),
+    ('Mazăre', 'mazăre', 'ro', 'Peas', 1),
+    ('Mazare', 'mazare', 'ro', 'Peas', 0),
+    ('Porumb', 'porumb', 'ro', 'Corn', 1),
+    ('Fasole verde', 'fasole verde', 'ro', 'Green Beans', 1),
+    ('Fasole albă', 'fasole albă', 'ro', 'White Beans', 1),
+    ('Fasole alba', 'fasole alba', 'ro', 'White Beans', 0),
+    ('Somon', 'somon', 'ro', 'Salmon', 1),
+    ('Ovăz', 'ovăz', 'ro', 'Oats', 1),
+    ('Ovaz', 'ovaz', 'ro', 'Oats', 0),
+    ('fulgi de ovăz', 'fulgi de ovăz', 'ro', 'Oats', 0),
+    ('fulgi de ovaz', 'fulgi de ovaz', 'ro', 'Oats', 0),
+    ('Țelină', 'țelină', 'ro', 'Celery', 1),
+    ('Telina', 'telina', 'ro', 'Celery', 0),
+    ('Sfeclă roșie', 'sfeclă roșie', 'ro', 'Beetroot', 1),
+    ('Sfecla rosie', 'sfecla rosie', 'ro', 'Beetroot', 0),
+    ('Dovleac', 'dovleac', 'ro', 'Pumpkin', 1),
+    ('Prune', 'prune', 'ro', 'Plum', 1),
+    ('prună', 'prună', 'ro', 'Plum', 0),
+    ('pruna', 'pruna', 'ro', 'Plum', 0),
+    ('Cireșe', 'cireșe', 'ro', 'Cherry', 1),
+    ('Cirese', 'cirese', 'ro', 'Cherry', 0),
+    ('cireașă', 'cireașă', 'ro', 'Cherry', 0),
+    ('cireasa', 'cireasa', 'ro', 'Cherry', 0),
+    ('Struguri', 'struguri', 'ro', 'Grapes', 1),
+    ('Pere', 'pere', 'ro', 'Pear', 1),
+    ('pară', 'pară', 'ro', 'Pear', 0),
+    ('para', 'para', 'ro', 'Pear', 0),
+    ('Piersici', 'piersici', 'ro', 'Peach', 1),
+    ('piersică', 'piersică', 'ro', 'Peach', 0),
+    ('piersica', 'piersica', 'ro', 'Peach', 0),
+    ('Pepene verde', 'pepene verde', 'ro', 'Watermelon', 1),
+    ('Brânză de vaci', 'brânză de vaci', 'ro', 'Cottage Cheese', 1),
+    ('Branza de vaci', 'branza de vaci', 'ro', 'Cottage Cheese', 0),
+    ('Portocale', 'portocale', 'ro', 'Orange', 1),
+    ('portocală', 'portocală', 'ro', 'Orange', 0),
+    ('portocala', 'portocala', 'ro', 'Orange', 0),
+    ('Tomate', 'tomate', 'es', 'Tomato', 1),
+    ('Cebolla', 'cebolla', 'es', 'Onion', 1),
+    ('Ajo', 'ajo', 'es', 'Garlic', 1),
+    ('Patata', 'patata', 'es', 'Potato', 1),
+    ('papa', 'papa', 'es', 'Potato', 0),
+    ('Zanahoria', 'zanahoria', 'es', 'Carrot', 1),
+    ('Berenjena', 'berenjena', 'es', 'Eggplant', 1),
+    ('Calabacín', 'calabacín', 'es', 'Zucchini', 1),
+    ('Calabacin', 'calabacin', 'es', 'Zucchini', 0),
+    ('Leche', 'leche', 'es', 'Milk', 1),
+    ('Huevo', 'huevo', 'es', 'Egg', 1),
+    ('huevos', 'huevos', 'es', 'Egg', 0),
+    ('Mantequilla', 'mantequilla', 'es', 'Butter', 1),
+    ('Azúcar', 'azúcar', 'es', 'Sugar', 1),
+    ('Azucar', 'azucar', 'es', 'Sugar', 0),
+    ('Arroz blanco', 'arroz blanco', 'es', 'White Rice', 1),
+    ('arroz', 'arroz', 'es', 'White Rice', 0),
+    ('Aceite de oliva', 'aceite de oliva', 'es', 'Olive Oil', 1),
+    ('Manzana', 'manzana', 'es', 'Apple', 1),
+    ('Garbanzos cocidos', 'garbanzos cocidos', 'es', 'Chickpeas', 1),
+    ('Lentejas', 'lentejas', 'es', 'Lentils', 1),
+    ('Espinacas', 'espinacas', 'es', 'Spinach', 1),
+    ('Pechuga de pollo', 'pechuga de pollo', 'es', 'Chicken Breast', 1),
+    ('Sal', 'sal', 'es', 'Salt', 1),
+    ('Miel', 'miel', 'es', 'Honey', 1),
+    ('Tomate', 'tomate', 'fr', 'Tomato', 1),
+    ('Oignon', 'oignon', 'fr', 'Onion', 1),
+    ('Ail', 'ail', 'fr', 'Garlic', 1),
+    ('Pomme de terre', 'pomme de terre', 'fr', 'Potato', 1),
+    ('pommes de terre', 'pommes de terre', 'fr', 'Potato', 0),
+    ('Carotte', 'carotte', 'fr', 'Carrot', 1),
+    ('Aubergine', 'aubergine', 'fr', 'Eggplant', 1),
+    ('Courgette', 'courgette', 'fr', 'Zucchini', 1),
+    ('Lait', 'lait', 'fr', 'Milk', 1),
+    ('Œuf', 'œuf', 'fr', 'Egg', 1),
+    ('Oeuf', 'oeuf', 'fr', 'Egg', 0),
+    ('Beurre', 'beurre', 'fr', 'Butter', 1),
+    ('Sucre', 'sucre', 'fr', 'Sugar', 1),
+    ('Riz blanc', 'riz blanc', 'fr', 'White Rice', 1),
+    ('riz', 'riz', 'fr', 'White Rice', 0),
+    ('Huile d''olive', 'huile d''olive', 'fr', 'Olive Oil', 1),
+    ('Pomme', 'pomme', 'fr', 'Apple', 1),
+    ('Pois chiches', 'pois chiches', 'fr', 'Chickpeas', 1),
+    ('Lentilles', 'lentilles', 'fr', 'Lentils', 1),
+    ('Épinards', 'épinards', 'fr', 'Spinach', 1),
+    ('Epinards', 'epinards', 'fr', 'Spinach', 0),
+    ('Blanc de poulet', 'blanc de poulet', 'fr', 'Chicken Breast', 1),
+    ('Sel', 'sel', 'fr', 'Salt', 1),
+    ('Miel', 'miel', 'fr', 'Honey', 1),
+    ('Tomate', 'tomate', 'de', 'Tomato', 1),
+    ('Zwiebel', 'zwiebel', 'de', 'Onion', 1),
+    ('Knoblauch', 'knoblauch', 'de', 'Garlic', 1),
+    ('Kartoffel', 'kartoffel', 'de', 'Potato', 1),
+    ('kartoffeln', 'kartoffeln', 'de', 'Potato', 0),
+    ('Karotte', 'karotte', 'de', 'Carrot', 1),
+    ('möhre', 'möhre', 'de', 'Carrot', 0),
+    ('mohre', 'mohre', 'de', 'Carrot', 0),
+    ('Aubergine', 'aubergine', 'de', 'Eggplant', 1),
+    ('Milch', 'milch', 'de', 'Milk', 1),
+    ('Ei', 'ei', 'de', 'Egg', 1),
+    ('eier', 'eier', 'de', 'Egg', 0),
+    ('Zucker', 'zucker', 'de', 'Sugar', 1),
+    ('Reis', 'reis', 'de', 'White Rice', 1),
+    ('Olivenöl', 'olivenöl', 'de', 'Olive Oil', 1),
+    ('Olivenol', 'olivenol', 'de', 'Olive Oil', 0),
+    ('Apfel', 'apfel', 'de', 'Apple', 1),
+    ('Kichererbsen', 'kichererbsen', 'de', 'Chickpeas', 1),
+    ('Linsen', 'linsen', 'de', 'Lentils', 1),
+    ('Spinat', 'spinat', 'de', 'Spinach', 1),
+    ('Hähnchenbrust', 'hähnchenbrust', 'de', 'Chicken Breast', 1),
+    ('Hahnchenbrust', 'hahnchenbrust', 'de', 'Chicken Breast', 0),
+    ('Salz', 'salz', 'de', 'Salt', 1),
+    ('Honig', 'honig', 'de', 'Honey', 1);
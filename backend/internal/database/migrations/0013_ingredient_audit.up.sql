@@ -0,0 +1,11 @@
+CREATE TABLE ingredient_audit (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    ingredient_name TEXT NOT NULL COLLATE NOCASE,
+    action TEXT NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    actor TEXT NOT NULL,
+    before_data TEXT,
+    after_data TEXT,
+    changed_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_ingredient_audit_name ON ingredient_audit (ingredient_name, changed_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_ingredients_fdc_id;
+
+ALTER TABLE ingredients DROP COLUMN FDC_ID;
+ALTER TABLE ingredients DROP COLUMN SOURCE;
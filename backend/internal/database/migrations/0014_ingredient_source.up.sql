@@ -0,0 +1,6 @@
+ALTER TABLE ingredients ADD COLUMN SOURCE TEXT;
+ALTER TABLE ingredients ADD COLUMN FDC_ID INTEGER;
+
+UPDATE ingredients SET SOURCE = 'seed';
+
+CREATE UNIQUE INDEX idx_ingredients_fdc_id ON ingredients (FDC_ID) WHERE FDC_ID IS NOT NULL;
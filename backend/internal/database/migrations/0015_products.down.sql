@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS product_nutrients;
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,22 @@
+CREATE TABLE products (
+    barcode TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    brand TEXT NOT NULL DEFAULT '',
+    serving_size TEXT NOT NULL DEFAULT '',
+    serving_grams REAL,
+    package_grams REAL,
+    calories REAL NOT NULL CHECK (calories >= 0),
+    proteins REAL NOT NULL CHECK (proteins >= 0),
+    carbs REAL NOT NULL CHECK (carbs >= 0),
+    fats REAL NOT NULL CHECK (fats >= 0),
+    fiber REAL NOT NULL DEFAULT 0 CHECK (fiber >= 0),
+    source TEXT NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+
+CREATE TABLE product_nutrients (
+    barcode TEXT NOT NULL REFERENCES products(barcode),
+    nutrient_key TEXT NOT NULL REFERENCES nutrients(key),
+    amount REAL NOT NULL CHECK (amount >= 0),
+    PRIMARY KEY (barcode, nutrient_key)
+);
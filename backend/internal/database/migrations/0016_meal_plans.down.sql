@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS meal_plan_meals;
+DROP TABLE IF EXISTS meal_plans;
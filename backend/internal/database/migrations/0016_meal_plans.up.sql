@@ -0,0 +1,26 @@
+CREATE TABLE meal_plans (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id TEXT NOT NULL,
+    start_date TEXT NOT NULL,
+    days INTEGER NOT NULL CHECK (days > 0),
+    meals TEXT NOT NULL,
+    max_repeats INTEGER NOT NULL,
+    restrictions TEXT NOT NULL DEFAULT '[]',
+    allergies TEXT NOT NULL DEFAULT '[]',
+    targets TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_meal_plans_session ON meal_plans (session_id);
+
+CREATE TABLE meal_plan_meals (
+    plan_id INTEGER NOT NULL REFERENCES meal_plans(id),
+    day INTEGER NOT NULL,
+    meal TEXT NOT NULL CHECK (meal IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    recipe_id INTEGER NOT NULL,
+    recipe_name TEXT NOT NULL,
+    portion REAL NOT NULL CHECK (portion > 0),
+    locked BOOLEAN NOT NULL DEFAULT 0,
+    PRIMARY KEY (plan_id, day, meal)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE pantry_items (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id TEXT NOT NULL,
+    ingredient_name TEXT NOT NULL COLLATE NOCASE,
+    grams REAL NOT NULL CHECK (grams > 0),
+    purchased_on TEXT,
+    best_before TEXT,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_pantry_items_session ON pantry_items (session_id, ingredient_name);
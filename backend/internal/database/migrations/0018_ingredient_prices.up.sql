@@ -0,0 +1,14 @@
+CREATE TABLE ingredient_prices (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    ingredient_name TEXT NOT NULL COLLATE NOCASE,
+    price REAL NOT NULL CHECK (price > 0),
+    unit TEXT NOT NULL CHECK (unit IN ('kg', 'pack')),
+    pack_grams REAL CHECK (pack_grams IS NULL OR pack_grams > 0),
+    currency TEXT NOT NULL,
+    store TEXT NOT NULL DEFAULT '',
+    priced_on TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    CHECK (unit = 'kg' OR pack_grams IS NOT NULL)
+);
+
+CREATE INDEX idx_ingredient_prices_lookup ON ingredient_prices (ingredient_name, currency, priced_on);
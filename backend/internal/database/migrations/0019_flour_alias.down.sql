@@ -0,0 +1 @@
+DELETE FROM ingredient_aliases WHERE locale = '' AND alias_key IN ('flour', 'white flour');
@@ -0,0 +1,3 @@
+INSERT OR IGNORE INTO ingredient_aliases (alias, alias_key, locale, ingredient_name, preferred) VALUES
+    ('flour', 'flour', '', 'All-Purpose Flour', 0),
+    ('white flour', 'white flour', '', 'All-Purpose Flour', 0);
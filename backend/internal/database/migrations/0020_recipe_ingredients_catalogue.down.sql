@@ -0,0 +1,11 @@
+DELETE FROM ingredient_aliases WHERE locale = '' AND alias_key IN ('cherry tomato', 'black olive');
+
+DELETE FROM ingredient_portions WHERE ingredient_name IN ('Cherry Tomatoes', 'Lemon') AND unit = 'piece';
+
+DELETE FROM ingredient_nutrients
+WHERE ingredient_name IN ('Black Olives', 'Cherry Tomatoes', 'Falafel', 'Lasagna Noodles', 'Lemon', 'Pizza Dough')
+  AND ingredient_name IN (SELECT NAME FROM ingredients WHERE SOURCE = 'seed');
+
+DELETE FROM ingredients
+WHERE SOURCE = 'seed'
+  AND NAME IN ('Black Olives', 'Cherry Tomatoes', 'Falafel', 'Lasagna Noodles', 'Lemon', 'Pizza Dough');
@@ -0,0 +1,58 @@
+INSERT OR IGNORE INTO ingredients (NAME, CALORIES, PROTEINS, CARBS, FATS, FIBER, SOURCE) VALUES
+    ('Black Olives', 115, 0.8, 6.3, 10.7, 3.2, 'seed'),
+    ('Cherry Tomatoes', 18, 0.9, 3.9, 0.2, 1.2, 'seed'),
+    ('Falafel', 333, 13.3, 31.8, 17.8, 4.9, 'seed'),
+    ('Lasagna Noodles', 371, 13.0, 75.0, 1.5, 3.2, 'seed'),
+    ('Lemon', 29, 1.1, 9.3, 0.3, 2.8, 'seed'),
+    ('Pizza Dough', 262, 7.7, 47.0, 4.3, 2.0, 'seed');
+
+INSERT OR IGNORE INTO ingredient_portions (ingredient_name, unit, grams) VALUES
+    ('Cherry Tomatoes', 'piece', 17),
+    ('Lemon', 'piece', 60);
+
+INSERT OR IGNORE INTO ingredient_aliases (alias, alias_key, locale, ingredient_name, preferred) VALUES
+    ('cherry tomato', 'cherry tomato', '', 'Cherry Tomatoes', 0),
+    ('black olive', 'black olive', '', 'Black Olives', 0);
+
+-- Values per 100 g, as for the rest of the catalogue in 0005_nutrients.
+CREATE TEMP TABLE seed_nutrients (
+    name TEXT PRIMARY KEY,
+    sugars REAL NOT NULL,
+    saturated_fat REAL NOT NULL,
+    cholesterol REAL NOT NULL,
+    sodium REAL NOT NULL,
+    potassium REAL NOT NULL,
+    calcium REAL NOT NULL,
+    iron REAL NOT NULL,
+    vitamin_c REAL NOT NULL,
+    vitamin_d REAL NOT NULL,
+    vitamin_b12 REAL NOT NULL
+);
+
+INSERT INTO seed_nutrients (name, sugars, saturated_fat, cholesterol, sodium, potassium, calcium, iron, vitamin_c, vitamin_d, vitamin_b12) VALUES
+    ('Black Olives', 0, 1.4, 0, 735, 8, 88, 3.3, 0.9, 0, 0),
+    ('Cherry Tomatoes', 2.6, 0.03, 0, 5, 237, 10, 0.3, 13.7, 0, 0),
+    ('Falafel', 1.5, 2.4, 0, 294, 585, 54, 3.4, 1.6, 0, 0),
+    ('Lasagna Noodles', 2.7, 0.3, 0, 6, 223, 21, 3.3, 0, 0, 0),
+    ('Lemon', 2.5, 0.04, 0, 2, 138, 26, 0.6, 53, 0, 0),
+    ('Pizza Dough', 2.5, 0.7, 0, 510, 110, 20, 3, 0, 0, 0);
+
+INSERT OR IGNORE INTO ingredient_nutrients (ingredient_name, nutrient_key, amount)
+SELECT i.NAME, n.key,
+    CASE n.key
+        WHEN 'sugars' THEN s.sugars
+        WHEN 'saturated_fat' THEN s.saturated_fat
+        WHEN 'cholesterol' THEN s.cholesterol
+        WHEN 'sodium' THEN s.sodium
+        WHEN 'potassium' THEN s.potassium
+        WHEN 'calcium' THEN s.calcium
+        WHEN 'iron' THEN s.iron
+        WHEN 'vitamin_c' THEN s.vitamin_c
+        WHEN 'vitamin_d' THEN s.vitamin_d
+        WHEN 'vitamin_b12' THEN s.vitamin_b12
+    END
+FROM seed_nutrients s
+JOIN ingredients i ON i.NAME = s.name
+JOIN nutrients n ON n.key IN ('sugars', 'saturated_fat', 'cholesterol', 'sodium', 'potassium', 'calcium', 'iron', 'vitamin_c', 'vitamin_d', 'vitamin_b12');
+
+DROP TABLE seed_nutrients;
@@ -0,0 +1,79 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"fmt"
+	"strings"
+)
+
+func ListNutrients() ([]config.NutrientDefinition, error) {
+	rows, err := DB.Query("SELECT key, name, unit FROM nutrients ORDER BY sort_order ASC, key ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []config.NutrientDefinition
+	for rows.Next() {
+		var def config.NutrientDefinition
+		if err := rows.Scan(&def.Key, &def.Name, &def.Unit); err != nil {
+			return nil, fmt.Errorf("scanning nutrient failed: %w", err)
+		}
+		list = append(list, def)
+	}
+	return list, rows.Err()
+}
+
+func ingredientNutrients(name string) (config.Nutrients, error) {
+	rows, err := DB.Query("SELECT nutrient_key, amount FROM ingredient_nutrients WHERE ingredient_name = ?", name)
+	if err != nil {
+		return nil, fmt.Errorf("querying ingredient nutrients failed: %w", err)
+	}
+	defer rows.Close()
+
+	var perCent config.Nutrients
+	for rows.Next() {
+		var key string
+		var amount float64
+		if err := rows.Scan(&key, &amount); err != nil {
+			return nil, fmt.Errorf("scanning ingredient nutrient failed: %w", err)
+		}
+		if perCent == nil {
+			perCent = make(config.Nutrients)
+		}
+		perCent[key] = amount
+	}
+	return perCent, rows.Err()
+}
+
+func recipeNutrients(recipeID int) (map[string]config.Nutrients, error) {
+	rows, err := DB.Query(`
+        SELECT DISTINCT ri.ingredient_name, n.nutrient_key, n.amount
+        FROM recipe_ingredients ri
+        JOIN ingredient_nutrients n ON n.ingredient_name = ri.ingredient_name
+        WHERE ri.recipe_id = ?`, recipeID)
+	if err != nil {
+		return nil, fmt.Errorf("querying recipe nutrients failed: %w", err)
+	}
+	defer rows.Close()
+
+	byIngredient := make(map[string]config.Nutrients)
+	for rows.Next() {
+		var name, key string
+		var amount float64
+		if err := rows.Scan(&name, &key, &amount); err != nil {
+			return nil, fmt.Errorf("scanning recipe nutrient failed: %w", err)
+		}
+		name = strings.ToLower(name)
+		if byIngredient[name] == nil {
+			byIngredient[name] = make(config.Nutrients)
+		}
+		byIngredient[name][key] = amount
+	}
+	return byIngredient, rows.Err()
+}
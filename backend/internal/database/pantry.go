@@ -0,0 +1,245 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrPantryItemNotFound = errors.New("pantry item not found")
+	ErrNotEnoughInPantry  = errors.New("not enough in the pantry")
+)
+
+// pantryEmptyGrams is the amount below which a lot counts as used up.
+const pantryEmptyGrams = 0.05
+
+// lotOrder uses the lots that expire first, then the oldest purchases.
+const lotOrder = `ORDER BY best_before IS NULL, best_before, purchased_on IS NULL, purchased_on, id`
+
+// usableLot keeps the lots that have not passed their best-before date,
+// given today's date. Expired lots stay in the list until deleted but are
+// neither counted as on hand nor consumed.
+const usableLot = `(best_before IS NULL OR best_before >= ?)`
+
+func today() string {
+	return time.Now().Format("2006-01-02")
+}
+
+// PantryRepository keeps each session's pantry. An ingredient may have
+// several lots, bought and expiring on different days.
+type PantryRepository struct {
+	mu sync.Mutex
+	db *sql.DB
+}
+
+func NewPantryRepository(db *sql.DB) (*PantryRepository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	return &PantryRepository{db: db}, nil
+}
+
+func (p *PantryRepository) Add(sessionID string, item config.PantryItem) (config.PantryItem, error) {
+	item.CreatedAt = time.Now().UTC()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res, err := p.db.Exec(`
+        INSERT INTO pantry_items (session_id, ingredient_name, grams, purchased_on, best_before, created_at)
+        VALUES (?, ?, ?, ?, ?, ?)`,
+		sessionID, item.Name, item.Grams,
+		sql.NullString{String: item.PurchasedOn, Valid: item.PurchasedOn != ""},
+		sql.NullString{String: item.BestBefore, Valid: item.BestBefore != ""},
+		item.CreatedAt)
+	if err != nil {
+		return item, fmt.Errorf("saving pantry item failed: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return item, err
+	}
+	item.ID = int(id)
+	item.ExpiresInDays = expiresIn(item.BestBefore, time.Now())
+	return item, nil
+}
+
+// List returns the session's pantry, soonest to expire first.
+func (p *PantryRepository) List(sessionID string) ([]config.PantryItem, error) {
+	rows, err := p.db.Query(`
+        SELECT id, ingredient_name, grams, COALESCE(purchased_on, ''), COALESCE(best_before, ''), created_at
+        FROM pantry_items
+        WHERE session_id = ?
+        `+lotOrder, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now := time.Now()
+	items := make([]config.PantryItem, 0)
+	for rows.Next() {
+		var item config.PantryItem
+		if err := rows.Scan(&item.ID, &item.Name, &item.Grams, &item.PurchasedOn, &item.BestBefore, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning pantry item failed: %w", err)
+		}
+		item.ExpiresInDays = expiresIn(item.BestBefore, now)
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (p *PantryRepository) Delete(sessionID string, id int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res, err := p.db.Exec("DELETE FROM pantry_items WHERE id = ? AND session_id = ?", id, sessionID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrPantryItemNotFound
+	}
+	return nil
+}
+
+// OnHand returns the grams of each ingredient in the pantry, keyed by
+// lower-case name. Lots past their best-before date are left out.
+func (p *PantryRepository) OnHand(sessionID string) (map[string]float64, error) {
+	rows, err := p.db.Query(`
+        SELECT LOWER(ingredient_name), SUM(grams)
+        FROM pantry_items
+        WHERE session_id = ? AND `+usableLot+`
+        GROUP BY LOWER(ingredient_name)`, sessionID, today())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	onHand := make(map[string]float64)
+	for rows.Next() {
+		var name string
+		var grams float64
+		if err := rows.Scan(&name, &grams); err != nil {
+			return nil, fmt.Errorf("scanning pantry item failed: %w", err)
+		}
+		onHand[name] = grams
+	}
+	return onHand, rows.Err()
+}
+
+// Consume takes the given grams of each ingredient out of the pantry, from
+// the lots that expire first. Lots past their best-before date are not
+// touched, as OnHand does not count them. Names are matched to the catalogue the same
+// way as when adding. Unless partial is set, nothing is taken when any
+// ingredient falls short; with partial set, what there is gets used. The
+// grams taken are returned by the name they are stored under.
+func (p *PantryRepository) Consume(sessionID string, grams map[string]float64, partial bool) (map[string]float64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tx, err := p.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	consumed := make(map[string]float64)
+	for name, want := range grams {
+		if want <= 0 {
+			continue
+		}
+		key := pantryName(name)
+		used, err := consumeLots(tx, sessionID, key, want, partial)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if used > 0 {
+			consumed[key] += used
+		}
+	}
+	return consumed, tx.Commit()
+}
+
+func consumeLots(tx *sql.Tx, sessionID, name string, want float64, partial bool) (float64, error) {
+	rows, err := tx.Query(`
+        SELECT id, grams FROM pantry_items
+        WHERE session_id = ? AND ingredient_name = ? AND `+usableLot+`
+        `+lotOrder, sessionID, name, today())
+	if err != nil {
+		return 0, err
+	}
+	type lot struct {
+		id    int
+		grams float64
+	}
+	var lots []lot
+	available := 0.0
+	for rows.Next() {
+		var l lot
+		if err := rows.Scan(&l.id, &l.grams); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scanning pantry item failed: %w", err)
+		}
+		lots = append(lots, l)
+		available += l.grams
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if !partial && available < want-pantryEmptyGrams {
+		return 0, ErrNotEnoughInPantry
+	}
+
+	used := 0.0
+	for _, l := range lots {
+		if used >= want {
+			break
+		}
+		take := min(l.grams, want-used)
+		used += take
+		if l.grams-take < pantryEmptyGrams {
+			_, err = tx.Exec("DELETE FROM pantry_items WHERE id = ?", l.id)
+		} else {
+			_, err = tx.Exec("UPDATE pantry_items SET grams = ? WHERE id = ?", l.grams-take, l.id)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return used, nil
+}
+
+// pantryName maps a recipe ingredient onto the lower-case catalogue name
+// the pantry stores, so "Tomatoes" uses up "tomato".
+func pantryName(name string) string {
+	canonical, _, err := findIngredient(strings.TrimSpace(name))
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(name))
+	}
+	return strings.ToLower(canonical)
+}
+
+// expiresIn counts the days from now until bestBefore, or returns nil when
+// there is no date.
+func expiresIn(bestBefore string, now time.Time) *int {
+	date, err := time.Parse("2006-01-02", bestBefore)
+	if err != nil {
+		return nil
+	}
+	today, _ := time.Parse("2006-01-02", now.Format("2006-01-02"))
+	days := int(date.Sub(today).Hours() / 24)
+	return &days
+}
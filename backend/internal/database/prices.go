@@ -0,0 +1,206 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPrice  = errors.New("invalid price")
+	ErrPriceNotFound = errors.New("price not found")
+)
+
+const (
+	maxPrice     = 1e6
+	maxPackGrams = 100000
+	maxStoreName = 100
+)
+
+// ValidateCurrency accepts three-letter upper-case ISO 4217 codes.
+func ValidateCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, c := range currency {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// AddPrice records a price for a catalogue ingredient. The ingredient may
+// be named as when adding it to the basket; the date defaults to today.
+func AddPrice(price config.IngredientPrice) (config.IngredientPrice, error) {
+	price.Currency = strings.ToUpper(strings.TrimSpace(price.Currency))
+	price.Store = strings.TrimSpace(price.Store)
+	if price.PricedOn == "" {
+		price.PricedOn = time.Now().Format("2006-01-02")
+	}
+	if err := validatePrice(price); err != nil {
+		return price, fmt.Errorf("%w: %v", ErrInvalidPrice, err)
+	}
+	if price.Unit == "kg" {
+		price.PackGrams = 0
+	}
+
+	canonical, _, err := findIngredient(strings.TrimSpace(price.Ingredient))
+	if err != nil {
+		return price, err
+	}
+	price.Ingredient = canonical
+	price.CreatedAt = time.Now().UTC()
+
+	res, err := DB.Exec(`
+        INSERT INTO ingredient_prices (ingredient_name, price, unit, pack_grams, currency, store, priced_on, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		price.Ingredient, price.Price, price.Unit, nullableGrams(price.PackGrams),
+		price.Currency, price.Store, price.PricedOn, price.CreatedAt)
+	if err != nil {
+		return price, fmt.Errorf("saving price failed: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return price, err
+	}
+	price.ID = int(id)
+	return price, nil
+}
+
+func validatePrice(price config.IngredientPrice) error {
+	switch {
+	case price.Price <= 0 || price.Price > maxPrice || math.IsNaN(price.Price):
+		return fmt.Errorf("price must be above 0 and at most %g", float64(maxPrice))
+	case price.Unit != "kg" && price.Unit != "pack":
+		return fmt.Errorf("unit must be kg or pack")
+	case price.Unit == "pack" && (price.PackGrams <= 0 || price.PackGrams > maxPackGrams):
+		return fmt.Errorf("a pack price needs pack_grams between 0 and %d", maxPackGrams)
+	case !ValidateCurrency(price.Currency):
+		return fmt.Errorf("currency must be a three-letter code such as EUR")
+	case len(price.Store) > maxStoreName:
+		return fmt.Errorf("store name is longer than %d characters", maxStoreName)
+	case !ValidateDate(price.PricedOn):
+		return fmt.Errorf("priced_on must be a YYYY-MM-DD date")
+	}
+	return nil
+}
+
+// PriceHistory lists the prices recorded for an ingredient, newest first,
+// optionally only those in one currency.
+func PriceHistory(ingredient, currency string) ([]config.IngredientPrice, error) {
+	canonical, _, err := findIngredient(strings.TrimSpace(ingredient))
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT id, ingredient_name, price, unit, COALESCE(pack_grams, 0), currency, store, priced_on, created_at
+        FROM ingredient_prices
+        WHERE ingredient_name = ?`
+	args := []interface{}{canonical}
+	if currency != "" {
+		query += " AND currency = ?"
+		args = append(args, strings.ToUpper(currency))
+	}
+	rows, err := DB.Query(query+" ORDER BY priced_on DESC, id DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prices := make([]config.IngredientPrice, 0)
+	for rows.Next() {
+		var p config.IngredientPrice
+		if err := rows.Scan(&p.ID, &p.Ingredient, &p.Price, &p.Unit, &p.PackGrams,
+			&p.Currency, &p.Store, &p.PricedOn, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning price failed: %w", err)
+		}
+		prices = append(prices, p)
+	}
+	return prices, rows.Err()
+}
+
+func DeletePrice(id int) error {
+	res, err := DB.Exec("DELETE FROM ingredient_prices WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrPriceNotFound
+	}
+	return nil
+}
+
+// PricesPerGram returns the latest price per gram of every ingredient
+// priced in the currency, keyed by lower-case name. Of prices recorded on
+// the same day, the one entered last wins.
+func PricesPerGram(currency string) (map[string]float64, error) {
+	rows, err := DB.Query(`
+        SELECT ingredient_name, price, unit, COALESCE(pack_grams, 0)
+        FROM ingredient_prices
+        WHERE currency = ?
+        ORDER BY priced_on, id`, currency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	perGram := make(map[string]float64)
+	for rows.Next() {
+		var name, unit string
+		var price, packGrams float64
+		if err := rows.Scan(&name, &price, &unit, &packGrams); err != nil {
+			return nil, fmt.Errorf("scanning price failed: %w", err)
+		}
+		if unit == "pack" {
+			perGram[strings.ToLower(name)] = price / packGrams
+		} else {
+			perGram[strings.ToLower(name)] = price / 1000
+		}
+	}
+	return perGram, rows.Err()
+}
+
+// CostOf prices the ingredients with perGram, from PricesPerGram. The
+// per-serving cost is only set when servings is above zero.
+func CostOf(ingredients []config.Ingredient, servings int, currency string, perGram map[string]float64) config.Cost {
+	cost := config.Cost{Currency: currency}
+	for _, ing := range ingredients {
+		price, ok := perGram[strings.ToLower(ing.Name)]
+		if !ok {
+			cost.Unpriced = append(cost.Unpriced, ing.Name)
+			continue
+		}
+		cost.Total += ing.Grams * price
+	}
+	sort.Strings(cost.Unpriced)
+	if servings > 0 {
+		cost.PerServing = roundCost(cost.Total / float64(servings))
+	}
+	cost.Total = roundCost(cost.Total)
+	return cost
+}
+
+// RecipeCost prices a recipe at the latest prices in the currency.
+func RecipeCost(recipe config.Recipe, currency string) (*config.Cost, error) {
+	perGram, err := PricesPerGram(currency)
+	if err != nil {
+		return nil, err
+	}
+	cost := CostOf(recipe.Ingredients, max(recipe.Servings, 1), currency, perGram)
+	return &cost, nil
+}
+
+func roundCost(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/units"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrProductNotFound = errors.New("product not found")
+	ErrInvalidProduct  = errors.New("invalid product")
+)
+
+// maxProductName is longer than the catalogue limit because product names
+// often include the flavour and pack size.
+const maxProductName = 200
+
+func GetProduct(barcode string) (config.Product, error) {
+	code, ok := NormalizeBarcode(barcode)
+	if !ok {
+		return config.Product{}, fmt.Errorf("%w: invalid barcode %q", ErrInvalidProduct, barcode)
+	}
+	return getProduct(DB, code)
+}
+
+func getProduct(q querier, barcode string) (config.Product, error) {
+	var p config.Product
+	var serving, pack sql.NullFloat64
+	err := q.QueryRow(`
+        SELECT barcode, name, brand, serving_size, serving_grams, package_grams,
+               calories, proteins, carbs, fats, fiber, source, updated_at
+        FROM products WHERE barcode = ?`, barcode).Scan(
+		&p.Barcode, &p.Name, &p.Brand, &p.ServingSize, &serving, &pack,
+		&p.Calories, &p.Proteins, &p.Carbs, &p.Fats, &p.Fiber, &p.Source, &p.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return p, ErrProductNotFound
+	}
+	if err != nil {
+		return p, err
+	}
+	p.ServingGrams, p.PackageGrams = serving.Float64, pack.Float64
+
+	rows, err := q.Query("SELECT nutrient_key, amount FROM product_nutrients WHERE barcode = ?", barcode)
+	if err != nil {
+		return p, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		var amount float64
+		if err := rows.Scan(&key, &amount); err != nil {
+			return p, err
+		}
+		if p.Nutrients == nil {
+			p.Nutrients = make(config.Nutrients)
+		}
+		p.Nutrients[key] = amount
+	}
+	return p, rows.Err()
+}
+
+// ReturnProduct works out the nutrition of an amount of a product, given in
+// grams or as a quantity in a mass unit, servings or packs.
+func ReturnProduct(barcode string, input config.TemplateIngredient) (config.Ingredient, error) {
+	product, err := GetProduct(barcode)
+	if err != nil {
+		return config.Ingredient{}, err
+	}
+
+	if input.Quantity > 0 {
+		unit, err := units.Lookup(input.Unit)
+		if err != nil {
+			return config.Ingredient{}, err
+		}
+		var portion float64
+		switch unit.Name {
+		case "serving":
+			portion = product.ServingGrams
+		case "pack":
+			portion = product.PackageGrams
+		}
+		grams, err := unit.ToGrams(input.Quantity, 0, portion)
+		if err != nil {
+			return config.Ingredient{}, fmt.Errorf("%s: %w", product.Name, err)
+		}
+		input.Unit = unit.Name
+		input.Grams = grams
+	}
+
+	input.Name = strings.ToLower(product.Name)
+	input.Barcode = product.Barcode
+
+	var data config.Ingredient
+	data.TemplateIngredient = input
+	data.NutritionalInfo = product.NutritionalInfo.Scaled(input.Grams / 100)
+	return data, nil
+}
+
+// UpsertProducts creates or updates products by barcode in one
+// transaction. Invalid products are skipped and reported, since exports
+// from Open Food Facts always contain some.
+func UpsertProducts(products []config.Product, dryRun bool) (UpsertResult, error) {
+	var result UpsertResult
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return result, err
+	}
+	defer tx.Rollback()
+
+	for _, p := range products {
+		outcome, err := writeProduct(tx, p)
+		if errors.Is(err, ErrInvalidProduct) {
+			result.Skipped = append(result.Skipped, SkippedRecord{Name: p.Barcode, Reason: err.Error()})
+			continue
+		}
+		if err != nil {
+			return UpsertResult{}, fmt.Errorf("product %s: %w", p.Barcode, err)
+		}
+		switch outcome {
+		case writeCreated:
+			result.Created++
+		case writeUpdated:
+			result.Updated++
+		default:
+			result.Unchanged++
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return UpsertResult{}, err
+	}
+	return result, nil
+}
+
+func validateProduct(p *config.Product) error {
+	code, ok := NormalizeBarcode(p.Barcode)
+	if !ok {
+		return fmt.Errorf("%w: invalid barcode %q", ErrInvalidProduct, p.Barcode)
+	}
+	p.Barcode = code
+	p.Name = strings.Join(strings.Fields(p.Name), " ")
+	p.Brand = strings.Join(strings.Fields(p.Brand), " ")
+	p.ServingSize = strings.TrimSpace(p.ServingSize)
+
+	if p.Name == "" || len(p.Name) > maxProductName || strings.IndexFunc(p.Name, unicode.IsControl) >= 0 {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidProduct, p.Name)
+	}
+	if len(p.Brand) > maxProductName || len(p.ServingSize) > maxProductName {
+		return fmt.Errorf("%w: brand or serving size too long", ErrInvalidProduct)
+	}
+	if p.ServingGrams < 0 || p.ServingGrams > 10000 || p.PackageGrams < 0 || p.PackageGrams > 100000 {
+		return fmt.Errorf("%w: invalid serving or package weight", ErrInvalidProduct)
+	}
+	// Labels round freely and list polyols as carbs, so the energy check
+	// used for the catalogue would reject many real products.
+	if err := validateMacros(p.NutritionalInfo, false); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProduct, err)
+	}
+	return nil
+}
+
+func writeProduct(tx *sql.Tx, p config.Product) (writeResult, error) {
+	if err := validateProduct(&p); err != nil {
+		return 0, err
+	}
+	if err := validateNutrients(tx, p.Nutrients, ErrInvalidProduct); err != nil {
+		return 0, err
+	}
+	if p.Source == "" {
+		return 0, fmt.Errorf("%w: missing source", ErrInvalidProduct)
+	}
+
+	before, err := getProduct(tx, p.Barcode)
+	isNew := err == ErrProductNotFound
+	if err != nil && !isNew {
+		return 0, err
+	}
+	if !isNew && sameProduct(before, p) {
+		return writeUnchanged, nil
+	}
+
+	_, err = tx.Exec(`
+        INSERT INTO products (barcode, name, brand, serving_size, serving_grams, package_grams,
+                              calories, proteins, carbs, fats, fiber, source, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT(barcode) DO UPDATE SET
+            name = excluded.name,
+            brand = excluded.brand,
+            serving_size = excluded.serving_size,
+            serving_grams = excluded.serving_grams,
+            package_grams = excluded.package_grams,
+            calories = excluded.calories,
+            proteins = excluded.proteins,
+            carbs = excluded.carbs,
+            fats = excluded.fats,
+            fiber = excluded.fiber,
+            source = excluded.source,
+            updated_at = excluded.updated_at`,
+		p.Barcode, p.Name, p.Brand, p.ServingSize, nullableGrams(p.ServingGrams), nullableGrams(p.PackageGrams),
+		p.Calories, p.Proteins, p.Carbs, p.Fats, p.Fiber, p.Source, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec("DELETE FROM product_nutrients WHERE barcode = ?", p.Barcode); err != nil {
+		return 0, err
+	}
+	for key, amount := range p.Nutrients {
+		if _, err := tx.Exec("INSERT INTO product_nutrients (barcode, nutrient_key, amount) VALUES (?, ?, ?)",
+			p.Barcode, key, amount); err != nil {
+			return 0, err
+		}
+	}
+
+	if isNew {
+		return writeCreated, nil
+	}
+	return writeUpdated, nil
+}
+
+func sameProduct(a, b config.Product) bool {
+	return a.Name == b.Name && a.Brand == b.Brand && a.ServingSize == b.ServingSize &&
+		a.ServingGrams == b.ServingGrams && a.PackageGrams == b.PackageGrams &&
+		a.Calories == b.Calories && a.Proteins == b.Proteins && a.Carbs == b.Carbs &&
+		a.Fats == b.Fats && a.Fiber == b.Fiber && a.Source == b.Source &&
+		sameNutrients(a.Nutrients, b.Nutrients)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type ProfileSnapshot struct {
+	config.UserProfile
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+type ProfileRepository struct {
+	mu sync.RWMutex
+	db *sql.DB
+}
+
+func NewProfileRepository(db *sql.DB) (*ProfileRepository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	return &ProfileRepository{db: db}, nil
+}
+
+func (p *ProfileRepository) Save(sessionID string, profile config.UserProfile) error {
+	restrictions, err := json.Marshal(profile.DietaryRestrictions)
+	if err != nil {
+		return err
+	}
+	allergies, err := json.Marshal(profile.Allergies)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	_, err = tx.Exec(`
+        INSERT INTO user_profiles
+            (session_id, age, gender, weight, height, activity_level, goal, dietary_restrictions, allergies, body_fat, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT(session_id) DO UPDATE SET
+            age = excluded.age,
+            gender = excluded.gender,
+            weight = excluded.weight,
+            height = excluded.height,
+            activity_level = excluded.activity_level,
+            goal = excluded.goal,
+            dietary_restrictions = excluded.dietary_restrictions,
+            allergies = excluded.allergies,
+            body_fat = excluded.body_fat,
+            updated_at = excluded.updated_at`,
+		sessionID, profile.Age, profile.Gender, profile.Weight, profile.Height,
+		profile.ActivityLevel, profile.Goal, string(restrictions), string(allergies), profile.BodyFat, now)
+	if err != nil {
+		return fmt.Errorf("saving profile failed: %w", err)
+	}
+
+	_, err = tx.Exec(`
+        INSERT INTO user_profile_history
+            (session_id, age, gender, weight, height, activity_level, goal, dietary_restrictions, allergies, body_fat, recorded_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		sessionID, profile.Age, profile.Gender, profile.Weight, profile.Height,
+		profile.ActivityLevel, profile.Goal, string(restrictions), string(allergies), profile.BodyFat, now)
+	if err != nil {
+		return fmt.Errorf("recording profile history failed: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (p *ProfileRepository) Get(sessionID string) (config.UserProfile, bool, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var profile config.UserProfile
+	var restrictions, allergies string
+	err := p.db.QueryRow(`
+        SELECT age, gender, weight, height, activity_level, goal, dietary_restrictions, allergies, body_fat
+        FROM user_profiles WHERE session_id = ?`, sessionID).
+		Scan(&profile.Age, &profile.Gender, &profile.Weight, &profile.Height,
+			&profile.ActivityLevel, &profile.Goal, &restrictions, &allergies, &profile.BodyFat)
+	if err == sql.ErrNoRows {
+		return config.UserProfile{}, false, nil
+	}
+	if err != nil {
+		return config.UserProfile{}, false, err
+	}
+
+	if err := json.Unmarshal([]byte(restrictions), &profile.DietaryRestrictions); err != nil {
+		return config.UserProfile{}, false, fmt.Errorf("decoding dietary restrictions failed: %w", err)
+	}
+	if err := json.Unmarshal([]byte(allergies), &profile.Allergies); err != nil {
+		return config.UserProfile{}, false, fmt.Errorf("decoding allergies failed: %w", err)
+	}
+	return profile, true, nil
+}
+
+func (p *ProfileRepository) Delete(sessionID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err := p.db.Exec("DELETE FROM user_profiles WHERE session_id = ?", sessionID)
+	return err
+}
+
+func (p *ProfileRepository) History(sessionID string) ([]ProfileSnapshot, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	rows, err := p.db.Query(`
+        SELECT age, gender, weight, height, activity_level, goal, dietary_restrictions, allergies, body_fat, recorded_at
+        FROM user_profile_history
+        WHERE session_id = ?
+        ORDER BY recorded_at ASC, id ASC`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]ProfileSnapshot, 0)
+	for rows.Next() {
+		var snap ProfileSnapshot
+		var restrictions, allergies string
+		if err := rows.Scan(&snap.Age, &snap.Gender, &snap.Weight, &snap.Height,
+			&snap.ActivityLevel, &snap.Goal, &restrictions, &allergies, &snap.BodyFat, &snap.RecordedAt); err != nil {
+			return nil, fmt.Errorf("scanning profile history failed: %w", err)
+		}
+		if err := json.Unmarshal([]byte(restrictions), &snap.DietaryRestrictions); err != nil {
+			return nil, fmt.Errorf("decoding dietary restrictions failed: %w", err)
+		}
+		if err := json.Unmarshal([]byte(allergies), &snap.Allergies); err != nil {
+			return nil, fmt.Errorf("decoding allergies failed: %w", err)
+		}
+		history = append(history, snap)
+	}
+	return history, rows.Err()
+}
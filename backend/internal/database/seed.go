@@ -0,0 +1,96 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+var seedDB []byte
+
+func SetSeed(data []byte) {
+	seedDB = data
+}
+
+// ensureDatabaseFile creates the database at dbPath when it is missing. The
+// default location is first filled from the one older releases used, once;
+// otherwise the embedded seed is written.
+func ensureDatabaseFile(dbPath string, logger *log.Logger) error {
+	if _, err := os.Stat(dbPath); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0o755); err != nil {
+		return fmt.Errorf("creating database directory failed: %w", err)
+	}
+
+	if legacy, ok := legacyDatabase(dbPath); ok {
+		logger.Printf("Importing existing database from %s", legacy)
+		return copyFile(legacy, dbPath)
+	}
+
+	if len(seedDB) == 0 {
+		return fmt.Errorf("no database at %s and no seed database available", dbPath)
+	}
+
+	logger.Printf("Creating database at %s from embedded seed", dbPath)
+	tmp := dbPath + ".tmp"
+	if err := os.WriteFile(tmp, seedDB, 0o644); err != nil {
+		return fmt.Errorf("writing seed database failed: %w", err)
+	}
+	return os.Rename(tmp, dbPath)
+}
+
+// legacyDatabase returns the database an older release left behind, when
+// dbPath is the default location and that database exists.
+func legacyDatabase(dbPath string) (string, bool) {
+	def, err := config.DefaultDBPath()
+	if err != nil {
+		return "", false
+	}
+	if def, err = filepath.Abs(def); err != nil || def != dbPath {
+		return "", false
+	}
+	legacy, err := config.LegacyDBPath()
+	if err != nil || legacy == dbPath {
+		return "", false
+	}
+	if _, err := os.Stat(legacy); err != nil {
+		return "", false
+	}
+	return legacy, true
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrIngredientExists = errors.New("ingredient already added")
+
+type SessionStore interface {
+	AddIngredient(sessionID string, ingredient config.Ingredient) error
+	ListIngredients(sessionID string) ([]config.Ingredient, error)
+	DeleteIngredient(sessionID, name string) error
+	Reset(sessionID string) error
+	ExpireSessions(maxAge time.Duration) error
+}
+
+func NewSessionStore(kind string) (SessionStore, error) {
+	switch kind {
+	case "memory":
+		return NewMemorySessionStore(), nil
+	case "sqlite", "":
+		return NewSQLiteSessionStore(DB)
+	default:
+		return nil, fmt.Errorf("unknown session store: %s", kind)
+	}
+}
+
+type memorySession struct {
+	lastSeen    time.Time
+	ingredients []config.Ingredient
+}
+
+type MemorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*memorySession
+}
+
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: make(map[string]*memorySession)}
+}
+
+func (s *MemorySessionStore) touch(sessionID string) *memorySession {
+	session, ok := s.sessions[sessionID]
+	if !ok {
+		session = &memorySession{}
+		s.sessions[sessionID] = session
+	}
+	session.lastSeen = time.Now()
+	return session
+}
+
+func (s *MemorySessionStore) AddIngredient(sessionID string, ingredient config.Ingredient) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session := s.touch(sessionID)
+	for _, ing := range session.ingredients {
+		if strings.EqualFold(ing.Name, ingredient.Name) {
+			return ErrIngredientExists
+		}
+	}
+	session.ingredients = append(session.ingredients, ingredient)
+	return nil
+}
+
+func (s *MemorySessionStore) ListIngredients(sessionID string) ([]config.Ingredient, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session := s.touch(sessionID)
+	list := make([]config.Ingredient, len(session.ingredients))
+	copy(list, session.ingredients)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+func (s *MemorySessionStore) DeleteIngredient(sessionID, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session := s.touch(sessionID)
+	newList := make([]config.Ingredient, 0, len(session.ingredients))
+	for _, ing := range session.ingredients {
+		if !strings.EqualFold(ing.Name, name) {
+			newList = append(newList, ing)
+		}
+	}
+	session.ingredients = newList
+	return nil
+}
+
+func (s *MemorySessionStore) Reset(sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.touch(sessionID).ingredients = nil
+	return nil
+}
+
+func (s *MemorySessionStore) ExpireSessions(maxAge time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, session := range s.sessions {
+		if now.Sub(session.lastSeen) > maxAge {
+			delete(s.sessions, id)
+		}
+	}
+	return nil
+}
+
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+type SQLiteSessionStore struct {
+	db *sql.DB
+}
+
+func NewSQLiteSessionStore(db *sql.DB) (*SQLiteSessionStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	return &SQLiteSessionStore{db: db}, nil
+}
+
+func (s *SQLiteSessionStore) touch(ex execer, sessionID string) error {
+	now := time.Now().UTC()
+	_, err := ex.Exec(`
+        INSERT INTO sessions (id, created_at, last_seen) VALUES (?, ?, ?)
+        ON CONFLICT(id) DO UPDATE SET last_seen = excluded.last_seen`,
+		sessionID, now, now)
+	return err
+}
+
+func (s *SQLiteSessionStore) AddIngredient(sessionID string, ingredient config.Ingredient) error {
+	data, err := json.Marshal(ingredient)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := s.touch(tx, sessionID); err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(`
+        INSERT INTO session_ingredients (session_id, name, data, added_at) VALUES (?, ?, ?, ?)
+        ON CONFLICT(session_id, name) DO NOTHING`,
+		sessionID, ingredient.Name, string(data), time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrIngredientExists
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLiteSessionStore) ListIngredients(sessionID string) ([]config.Ingredient, error) {
+	if err := s.touch(s.db, sessionID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Query("SELECT data FROM session_ingredients WHERE session_id = ? ORDER BY name ASC", sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]config.Ingredient, 0)
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("scanning session ingredient failed: %w", err)
+		}
+		var ing config.Ingredient
+		if err := json.Unmarshal([]byte(data), &ing); err != nil {
+			return nil, fmt.Errorf("decoding session ingredient failed: %w", err)
+		}
+		list = append(list, ing)
+	}
+	return list, rows.Err()
+}
+
+func (s *SQLiteSessionStore) DeleteIngredient(sessionID, name string) error {
+	if err := s.touch(s.db, sessionID); err != nil {
+		return err
+	}
+	_, err := s.db.Exec("DELETE FROM session_ingredients WHERE session_id = ? AND name = ?", sessionID, name)
+	return err
+}
+
+func (s *SQLiteSessionStore) Reset(sessionID string) error {
+	if err := s.touch(s.db, sessionID); err != nil {
+		return err
+	}
+	_, err := s.db.Exec("DELETE FROM session_ingredients WHERE session_id = ?", sessionID)
+	return err
+}
+
+func (s *SQLiteSessionStore) ExpireSessions(maxAge time.Duration) error {
+	cutoff := time.Now().UTC().Add(-maxAge)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`
+        DELETE FROM session_ingredients
+        WHERE session_id IN (SELECT id FROM sessions WHERE last_seen < ?)`, cutoff); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM sessions WHERE last_seen < ?", cutoff); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
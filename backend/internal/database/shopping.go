@@ -0,0 +1,71 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PurchaseInfo is what a shopping list needs to know about an ingredient:
+// its food group, its density in g/ml and the weight of each counted unit
+// it comes in.
+type PurchaseInfo struct {
+	Name      string
+	FoodGroup string
+	Density   float64
+	Portions  map[string]float64
+}
+
+// IngredientPurchaseInfo returns the purchase details of every catalogue
+// ingredient, keyed by lower-case name.
+func IngredientPurchaseInfo() (map[string]PurchaseInfo, error) {
+	rows, err := DB.Query(`
+        SELECT i.NAME, COALESCE(i.DENSITY, 0), COALESCE(m.food_group, '')
+        FROM ingredients i
+        LEFT JOIN ingredient_metadata m ON m.ingredient_name = i.NAME`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	info := make(map[string]PurchaseInfo)
+	for rows.Next() {
+		var p PurchaseInfo
+		if err := rows.Scan(&p.Name, &p.Density, &p.FoodGroup); err != nil {
+			return nil, fmt.Errorf("scanning ingredient failed: %w", err)
+		}
+		info[strings.ToLower(p.Name)] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	portionRows, err := DB.Query("SELECT ingredient_name, unit, grams FROM ingredient_portions")
+	if err != nil {
+		return nil, err
+	}
+	defer portionRows.Close()
+
+	for portionRows.Next() {
+		var name, unit string
+		var grams float64
+		if err := portionRows.Scan(&name, &unit, &grams); err != nil {
+			return nil, fmt.Errorf("scanning ingredient portion failed: %w", err)
+		}
+		p, ok := info[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+		if p.Portions == nil {
+			p.Portions = make(map[string]float64)
+		}
+		p.Portions[unit] = grams
+		info[strings.ToLower(name)] = p
+	}
+	return info, portionRows.Err()
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidTaxonomy = errors.New("invalid taxonomy")
+
+// TaxonomyColumns is the CSV header used by ImportTaxonomy and
+// ExportTaxonomy. Allergen keys are separated by semicolons.
+var TaxonomyColumns = []string{"name", "food_group", "animal_derived", "contains_meat", "contains_gluten", "contains_lactose", "allergens"}
+
+func ListAllergens() ([]config.Allergen, error) {
+	rows, err := DB.Query("SELECT key, name, eu14, us9 FROM allergens ORDER BY key ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []config.Allergen
+	for rows.Next() {
+		var a config.Allergen
+		if err := rows.Scan(&a.Key, &a.Name, &a.EU14, &a.US9); err != nil {
+			return nil, fmt.Errorf("scanning allergen failed: %w", err)
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+func GetIngredientInfo(name string) (config.IngredientInfo, error) {
+	taxonomy, err := loadTaxonomy("WHERE m.ingredient_name = ?", name)
+	if err != nil {
+		return config.IngredientInfo{}, err
+	}
+	info, ok := taxonomy[strings.ToLower(name)]
+	if !ok {
+		return info, ErrIngredientNotFound
+	}
+	return info, nil
+}
+
+// IngredientTaxonomy returns the metadata for every known ingredient,
+// keyed by lower-cased name.
+func IngredientTaxonomy() (map[string]config.IngredientInfo, error) {
+	return loadTaxonomy("")
+}
+
+func loadTaxonomy(where string, args ...interface{}) (map[string]config.IngredientInfo, error) {
+	rows, err := DB.Query(`
+        SELECT m.ingredient_name, m.food_group, m.animal_derived, m.contains_meat,
+               m.contains_gluten, m.contains_lactose, COALESCE(a.allergen_key, '')
+        FROM ingredient_metadata m
+        LEFT JOIN ingredient_allergens a ON a.ingredient_name = m.ingredient_name
+        `+where+`
+        ORDER BY m.ingredient_name, a.allergen_key`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying ingredient metadata failed: %w", err)
+	}
+	defer rows.Close()
+
+	taxonomy := make(map[string]config.IngredientInfo)
+	for rows.Next() {
+		var info config.IngredientInfo
+		var allergen string
+		if err := rows.Scan(&info.Name, &info.FoodGroup, &info.AnimalDerived, &info.ContainsMeat,
+			&info.ContainsGluten, &info.ContainsLactose, &allergen); err != nil {
+			return nil, fmt.Errorf("scanning ingredient metadata failed: %w", err)
+		}
+		key := strings.ToLower(info.Name)
+		if existing, ok := taxonomy[key]; ok {
+			info = existing
+		}
+		if info.Allergens == nil {
+			info.Allergens = []string{}
+		}
+		if allergen != "" {
+			info.Allergens = append(info.Allergens, allergen)
+		}
+		taxonomy[key] = info
+	}
+	return taxonomy, rows.Err()
+}
+
+// applyDietFlags derives the recipe-level flags from its ingredients. A
+// recipe is only vegan or vegetarian when every ingredient is classified.
+func applyDietFlags(recipe *config.Recipe, taxonomy map[string]config.IngredientInfo) {
+	recipe.Vegan, recipe.Vegetarian = len(recipe.Ingredients) > 0, len(recipe.Ingredients) > 0
+	allergens := make(map[string]bool)
+	for _, ing := range recipe.Ingredients {
+		info, ok := taxonomy[strings.ToLower(ing.Name)]
+		if !ok {
+			recipe.Vegan, recipe.Vegetarian = false, false
+			continue
+		}
+		if info.AnimalDerived {
+			recipe.Vegan = false
+		}
+		if info.ContainsMeat {
+			recipe.Vegetarian = false
+		}
+		for _, a := range info.Allergens {
+			allergens[a] = true
+		}
+	}
+
+	recipe.Allergens = nil
+	for a := range allergens {
+		recipe.Allergens = append(recipe.Allergens, a)
+	}
+	sort.Strings(recipe.Allergens)
+}
+
+// ImportTaxonomy upserts ingredient metadata from CSV with the
+// TaxonomyColumns header. An ingredient's allergen list is replaced by the
+// one in the file. Nothing is written if any row is invalid.
+func ImportTaxonomy(r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("%w: reading header: %v", ErrInvalidTaxonomy, err)
+	}
+	columns := make(map[string]int)
+	for i, col := range header {
+		columns[strings.ToLower(strings.TrimSpace(col))] = i
+	}
+	for _, col := range TaxonomyColumns {
+		if _, ok := columns[col]; !ok {
+			return 0, fmt.Errorf("%w: missing column %q", ErrInvalidTaxonomy, col)
+		}
+	}
+
+	known, err := ListAllergens()
+	if err != nil {
+		return 0, err
+	}
+	validAllergen := make(map[string]bool, len(known))
+	for _, a := range known {
+		validAllergen[a.Key] = true
+	}
+
+	var infos []config.IngredientInfo
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrInvalidTaxonomy, err)
+		}
+		info, err := parseTaxonomyRecord(record, columns, validAllergen)
+		if err != nil {
+			return 0, fmt.Errorf("%w: line %d: %v", ErrInvalidTaxonomy, line, err)
+		}
+		infos = append(infos, info)
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	for _, info := range infos {
+		_, err := tx.Exec(`
+            INSERT INTO ingredient_metadata
+                (ingredient_name, food_group, animal_derived, contains_meat, contains_gluten, contains_lactose)
+            VALUES (?, ?, ?, ?, ?, ?)
+            ON CONFLICT(ingredient_name) DO UPDATE SET
+                food_group = excluded.food_group,
+                animal_derived = excluded.animal_derived,
+                contains_meat = excluded.contains_meat,
+                contains_gluten = excluded.contains_gluten,
+                contains_lactose = excluded.contains_lactose`,
+			info.Name, info.FoodGroup, info.AnimalDerived, info.ContainsMeat, info.ContainsGluten, info.ContainsLactose)
+		if err != nil {
+			return 0, fmt.Errorf("saving %s failed: %w", info.Name, err)
+		}
+		if _, err := tx.Exec("DELETE FROM ingredient_allergens WHERE ingredient_name = ?", info.Name); err != nil {
+			return 0, err
+		}
+		for _, a := range info.Allergens {
+			if _, err := tx.Exec("INSERT OR IGNORE INTO ingredient_allergens (ingredient_name, allergen_key) VALUES (?, ?)", info.Name, a); err != nil {
+				return 0, fmt.Errorf("saving allergens for %s failed: %w", info.Name, err)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(infos), nil
+}
+
+func parseTaxonomyRecord(record []string, columns map[string]int, validAllergen map[string]bool) (config.IngredientInfo, error) {
+	field := func(col string) string {
+		if i := columns[col]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	info := config.IngredientInfo{
+		Name:      field("name"),
+		FoodGroup: strings.ToLower(field("food_group")),
+	}
+	if !ValidateCatalogName(info.Name) {
+		return info, fmt.Errorf("invalid ingredient name %q", info.Name)
+	}
+
+	flags := []struct {
+		col string
+		dst *bool
+	}{
+		{"animal_derived", &info.AnimalDerived},
+		{"contains_meat", &info.ContainsMeat},
+		{"contains_gluten", &info.ContainsGluten},
+		{"contains_lactose", &info.ContainsLactose},
+	}
+	for _, f := range flags {
+		value := field(f.col)
+		if value == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return info, fmt.Errorf("%s: %q is not a boolean", f.col, value)
+		}
+		*f.dst = b
+	}
+	if info.ContainsMeat && !info.AnimalDerived {
+		return info, fmt.Errorf("%s contains meat but is not animal derived", info.Name)
+	}
+
+	for _, a := range strings.Split(field("allergens"), ";") {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == "" {
+			continue
+		}
+		if !validAllergen[a] {
+			return info, fmt.Errorf("unknown allergen %q", a)
+		}
+		info.Allergens = append(info.Allergens, a)
+	}
+	return info, nil
+}
+
+// ExportTaxonomy writes all ingredient metadata as CSV in the format read
+// by ImportTaxonomy.
+func ExportTaxonomy(w io.Writer) error {
+	taxonomy, err := IngredientTaxonomy()
+	if err != nil {
+		return err
+	}
+	infos := make([]config.IngredientInfo, 0, len(taxonomy))
+	for _, info := range taxonomy {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return strings.ToLower(infos[i].Name) < strings.ToLower(infos[j].Name) })
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(TaxonomyColumns); err != nil {
+		return err
+	}
+	for _, info := range infos {
+		record := []string{
+			info.Name,
+			info.FoodGroup,
+			strconv.FormatBool(info.AnimalDerived),
+			strconv.FormatBool(info.ContainsMeat),
+			strconv.FormatBool(info.ContainsGluten),
+			strconv.FormatBool(info.ContainsLactose),
+			strings.Join(info.Allergens, ";"),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package database
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/units"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+func findIngredient(name string) (string, sql.NullFloat64, error) {
+	var canonical string
+	var density sql.NullFloat64
+
+	candidates := append([]string{name}, units.SingularCandidates(name)...)
+	for _, candidate := range candidates {
+		err := DB.QueryRow("SELECT NAME, DENSITY FROM ingredients WHERE LOWER(NAME) = LOWER(?)", candidate).
+			Scan(&canonical, &density)
+		if err == nil {
+			return canonical, density, nil
+		}
+		if err != sql.ErrNoRows {
+			return "", density, err
+		}
+	}
+
+	for _, candidate := range candidates {
+		resolved, err := ResolveIngredientName(candidate)
+		if err == ErrIngredientNotFound {
+			continue
+		}
+		if err != nil {
+			return "", density, err
+		}
+		err = DB.QueryRow("SELECT NAME, DENSITY FROM ingredients WHERE NAME = ?", resolved).Scan(&canonical, &density)
+		return canonical, density, err
+	}
+	return "", density, ErrIngredientNotFound
+}
+
+func portionGrams(ingredientName, unit string) (float64, error) {
+	var grams float64
+	err := DB.QueryRow("SELECT grams FROM ingredient_portions WHERE ingredient_name = ? AND unit = ?",
+		ingredientName, unit).Scan(&grams)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return grams, err
+}
+
+// ResolveQuantity maps a possibly plural ingredient name onto the catalogue
+// and, when a quantity and unit are given, converts them to grams using the
+// ingredient's density or standard portion weight.
+func ResolveQuantity(input config.TemplateIngredient) (config.TemplateIngredient, error) {
+	canonical, density, err := findIngredient(input.Name)
+	if err != nil {
+		return input, err
+	}
+	if !strings.EqualFold(canonical, input.Name) {
+		input.Name = strings.ToLower(canonical)
+	}
+
+	if input.Quantity <= 0 {
+		return input, nil
+	}
+
+	unit, err := units.Lookup(input.Unit)
+	if err != nil {
+		return input, err
+	}
+
+	var portion float64
+	if unit.Kind == units.Count {
+		if portion, err = portionGrams(canonical, unit.Name); err != nil {
+			return input, err
+		}
+	}
+
+	grams, err := unit.ToGrams(input.Quantity, density.Float64, portion)
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", canonical, err)
+	}
+	input.Unit = unit.Name
+	input.Grams = grams
+	return input, nil
+}
@@ -6,9 +6,12 @@
 package database
 
 import (
+	"FoodStats/internal/config"
 	"html"
 	"regexp"
 	"strings"
+	"time"
+	"unicode"
 )
 
 func ValidateIngredientName(name string) bool {
@@ -16,10 +19,69 @@ func ValidateIngredientName(name string) bool {
 	return matched && len(name) <= 100
 }
 
+// ValidateCatalogName checks a name for the ingredients table. Unlike
+// ValidateIngredientName it accepts accented letters, which the catalogue
+// already contains.
+func ValidateCatalogName(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return false
+	}
+	for _, c := range name {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && !strings.ContainsRune(" -.,()'&/%", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeBarcode strips spaces and dashes from an EAN-8, UPC-A, EAN-13
+// or GTIN-14 code and returns it in the 13-digit form Open Food Facts uses
+// for UPC-A and GTIN-14 codes with a leading zero. Codes of any other
+// length are rejected.
+func NormalizeBarcode(code string) (string, bool) {
+	code = strings.NewReplacer(" ", "", "-", "").Replace(code)
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return "", false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return "", false
+		}
+	}
+	switch {
+	case len(code) == 12:
+		code = "0" + code
+	case len(code) == 14 && code[0] == '0':
+		code = code[1:]
+	}
+	return code, true
+}
+
 func ValidateGrams(grams float64) bool {
 	return grams > 0 && grams <= 10000
 }
 
+func ValidateServings(servings int) bool {
+	return servings > 0 && servings <= 100
+}
+
+func ValidateMeal(meal string) bool {
+	for _, m := range config.MealTypes {
+		if meal == m {
+			return true
+		}
+	}
+	return false
+}
+
+func ValidateDate(date string) bool {
+	_, err := time.Parse("2006-01-02", date)
+	return err == nil
+}
+
 func SanitizeDescription(description string) string {
 	return html.EscapeString(strings.TrimSpace(description))
 }
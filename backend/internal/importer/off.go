@@ -0,0 +1,294 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package importer
+
+import (
+	"FoodStats/internal/config"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// OpenFoodFactsSource is recorded on every imported product.
+const OpenFoodFactsSource = "openfoodfacts"
+
+// offNutrients maps extended nutrient keys onto Open Food Facts nutriment
+// names. The export gives every *_100g value in grams.
+var offNutrients = []struct {
+	key   string
+	field string
+}{
+	{"sugars", "sugars"},
+	{"added_sugars", "added-sugars"},
+	{"saturated_fat", "saturated-fat"},
+	{"trans_fat", "trans-fat"},
+	{"monounsaturated_fat", "monounsaturated-fat"},
+	{"polyunsaturated_fat", "polyunsaturated-fat"},
+	{"cholesterol", "cholesterol"},
+	{"sodium", "sodium"},
+	{"potassium", "potassium"},
+	{"calcium", "calcium"},
+	{"iron", "iron"},
+	{"magnesium", "magnesium"},
+	{"zinc", "zinc"},
+	{"vitamin_a", "vitamin-a"},
+	{"vitamin_c", "vitamin-c"},
+	{"vitamin_d", "vitamin-d"},
+	{"vitamin_e", "vitamin-e"},
+	{"vitamin_k", "vitamin-k"},
+	{"vitamin_b6", "vitamin-b6"},
+	{"vitamin_b12", "vitamin-b12"},
+	{"folate", "folates"},
+}
+
+// saltPerSodium converts salt to sodium when a label only lists salt.
+const saltPerSodium = 2.5
+
+// OFFOptions configures ReadOpenFoodFacts.
+type OFFOptions struct {
+	// NutrientUnits maps extended nutrient keys onto the unit they are
+	// stored in. Nutrients missing here are not imported.
+	NutrientUnits map[string]string
+}
+
+// offJSONProduct holds the fields of a JSONL export line that are used.
+// Codes and quantities are strings in some lines and numbers in others.
+type offJSONProduct struct {
+	Code            offValue               `json:"code"`
+	ProductName     string                 `json:"product_name"`
+	ProductNameEN   string                 `json:"product_name_en"`
+	GenericName     string                 `json:"generic_name"`
+	Brands          string                 `json:"brands"`
+	ServingSize     string                 `json:"serving_size"`
+	ServingQuantity offValue               `json:"serving_quantity"`
+	ProductQuantity offValue               `json:"product_quantity"`
+	Nutriments      map[string]interface{} `json:"nutriments"`
+}
+
+type offValue string
+
+func (v *offValue) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*v = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*v = offValue(s)
+		return nil
+	}
+	*v = offValue(data)
+	return nil
+}
+
+// OFFStats counts the rows of an export. Products are the rows passed on
+// with a barcode, a name and complete macros.
+type OFFStats struct {
+	Rows      int
+	Products  int
+	Malformed int
+}
+
+// ReadOpenFoodFacts streams an Open Food Facts export and passes each
+// product with a barcode, a name and complete macros to fn. The file may
+// be the JSONL dump or the tab-separated CSV export, either one optionally
+// gzipped, told apart by extension.
+func ReadOpenFoodFacts(path string, opts OFFOptions, fn func(config.Product) error) (OFFStats, error) {
+	var stats OFFStats
+	f, err := os.Open(path)
+	if err != nil {
+		return stats, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	name := strings.ToLower(filepath.Base(path))
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return stats, err
+		}
+		defer gz.Close()
+		r = gz
+		name = strings.TrimSuffix(name, ".gz")
+	}
+
+	emit := func(field func(string) string) error {
+		stats.Rows++
+		if product, ok := offProduct(field, opts.NutrientUnits); ok {
+			stats.Products++
+			return fn(product)
+		}
+		return nil
+	}
+
+	switch filepath.Ext(name) {
+	case ".jsonl", ".ndjson", ".json":
+		err = readOFFJSON(r, &stats, emit)
+	case ".csv", ".tsv":
+		err = readCSVRows(r, filepath.Base(path), []string{"code", "product_name"}, func(row map[string]string) error {
+			return emit(func(key string) string { return row[key] })
+		})
+	default:
+		return stats, fmt.Errorf("%s: expected a .jsonl or .csv export", filepath.Base(path))
+	}
+	return stats, err
+}
+
+// readOFFJSON reads one product per line. A line that is not valid JSON
+// is counted and skipped, so one damaged record does not stop an import.
+func readOFFJSON(r io.Reader, stats *OFFStats, emit func(field func(string) string) error) error {
+	reader := bufio.NewReaderSize(r, 1<<20)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var p offJSONProduct
+			if jsonErr := json.Unmarshal(line, &p); jsonErr != nil {
+				stats.Malformed++
+			} else if emitErr := emit(jsonField(&p)); emitErr != nil {
+				return emitErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func jsonField(p *offJSONProduct) func(string) string {
+	return func(key string) string {
+		switch key {
+		case "code":
+			return string(p.Code)
+		case "product_name":
+			return p.ProductName
+		case "product_name_en":
+			return p.ProductNameEN
+		case "generic_name":
+			return p.GenericName
+		case "brands":
+			return p.Brands
+		case "serving_size":
+			return p.ServingSize
+		case "serving_quantity":
+			return string(p.ServingQuantity)
+		case "product_quantity":
+			return string(p.ProductQuantity)
+		}
+		switch v := p.Nutriments[key].(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case string:
+			return v
+		}
+		return ""
+	}
+}
+
+// offProduct builds a product from the columns of one export row. Rows
+// without energy, protein, carbs or fat are left out.
+func offProduct(field func(string) string, units map[string]string) (config.Product, bool) {
+	p := config.Product{
+		Barcode:     strings.TrimSpace(field("code")),
+		Name:        firstNonEmpty(field("product_name"), field("product_name_en"), field("generic_name")),
+		ServingSize: field("serving_size"),
+		Source:      OpenFoodFactsSource,
+	}
+	if p.Barcode == "" || p.Name == "" {
+		return p, false
+	}
+	// Brands are comma-separated, owner first.
+	p.Brand = strings.TrimSpace(strings.Split(field("brands"), ",")[0])
+	// Quantities of drinks are in millilitres, which are close enough to
+	// grams for serving and pack sizes.
+	p.ServingGrams, _ = offNumber(field("serving_quantity"))
+	p.PackageGrams, _ = offNumber(field("product_quantity"))
+
+	var ok [4]bool
+	if p.Calories, ok[0] = offNumber(field("energy-kcal_100g")); !ok[0] {
+		var kj float64
+		if kj, ok[0] = offNumber(field("energy_100g")); ok[0] {
+			p.Calories = kj / 4.184
+		}
+	}
+	p.Proteins, ok[1] = offNumber(field("proteins_100g"))
+	p.Carbs, ok[2] = offNumber(field("carbohydrates_100g"))
+	p.Fats, ok[3] = offNumber(field("fat_100g"))
+	for _, present := range ok {
+		if !present {
+			return p, false
+		}
+	}
+	p.Fiber, _ = offNumber(field("fiber_100g"))
+	for _, v := range []*float64{&p.Calories, &p.Proteins, &p.Carbs, &p.Fats, &p.Fiber, &p.ServingGrams, &p.PackageGrams} {
+		*v = round3(*v)
+	}
+
+	for _, n := range offNutrients {
+		unit := units[n.key]
+		if unit == "" {
+			continue
+		}
+		grams, found := offNumber(field(n.field + "_100g"))
+		if !found && n.key == "sodium" {
+			if salt, ok := offNumber(field("salt_100g")); ok {
+				grams, found = salt/saltPerSodium, true
+			}
+		}
+		if !found {
+			continue
+		}
+		value, err := convertUnit(grams, "g", unit, 0)
+		if err != nil {
+			continue
+		}
+		if p.Nutrients == nil {
+			p.Nutrients = make(config.Nutrients)
+		}
+		p.Nutrients[n.key] = round3(value)
+	}
+	return p, true
+}
+
+// offNumber parses an export value, which may use a decimal comma.
+func offNumber(s string) (float64, bool) {
+	s = strings.TrimSpace(strings.Replace(s, ",", ".", 1))
+	if s == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return v, true
+}
+
+func round3(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,362 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+// Package importer reads third-party food databases into catalogue
+// records.
+package importer
+
+import (
+	"FoodStats/internal/config"
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// USDA FoodData Central data types that carry complete per-100g profiles.
+const (
+	FoundationFood = "foundation_food"
+	SRLegacyFood   = "sr_legacy_food"
+)
+
+var ErrUnsupportedUnit = errors.New("unsupported unit")
+
+// fdcNutrients lists, for each catalogue field, the FDC nutrient IDs that
+// can fill it, best first. Foundation foods often only report energy as
+// Atwater general or specific factors, and SR Legacy uses older IDs.
+var fdcNutrients = []struct {
+	key string
+	ids []int
+}{
+	{"calories", []int{1008, 2048, 2047, 1062}},
+	{"proteins", []int{1003}},
+	{"carbs", []int{1005, 1050}},
+	{"fats", []int{1004, 1085}},
+	{"fiber", []int{1079}},
+	{"sugars", []int{2000, 1063}},
+	{"added_sugars", []int{1235}},
+	{"saturated_fat", []int{1258}},
+	{"trans_fat", []int{1257}},
+	{"monounsaturated_fat", []int{1292}},
+	{"polyunsaturated_fat", []int{1293}},
+	{"cholesterol", []int{1253}},
+	{"sodium", []int{1093}},
+	{"potassium", []int{1092}},
+	{"calcium", []int{1087}},
+	{"iron", []int{1089}},
+	{"magnesium", []int{1090}},
+	{"zinc", []int{1095}},
+	{"vitamin_a", []int{1106}},
+	{"vitamin_c", []int{1162}},
+	{"vitamin_d", []int{1114, 1110}},
+	{"vitamin_e", []int{1109}},
+	{"vitamin_k", []int{1185}},
+	{"vitamin_b6", []int{1175}},
+	{"vitamin_b12", []int{1178}},
+	{"folate", []int{1177, 1190}},
+}
+
+// macroUnits are the units of the fixed catalogue columns.
+var macroUnits = map[string]string{
+	"calories": "kcal",
+	"proteins": "g",
+	"carbs":    "g",
+	"fats":     "g",
+	"fiber":    "g",
+}
+
+// vitaminDPerIU converts vitamin D from international units to µg. Other
+// IU values depend on the compound and are not converted.
+const vitaminDPerIU = 0.025
+
+// USDAOptions selects what ReadUSDA imports.
+type USDAOptions struct {
+	// DataTypes defaults to Foundation and SR Legacy foods.
+	DataTypes []string
+	// NutrientUnits maps extended nutrient keys onto the unit they are
+	// stored in. Nutrients missing here are not imported.
+	NutrientUnits map[string]string
+}
+
+type fdcFood struct {
+	description string
+	dataType    string
+	amounts     map[int]float64
+}
+
+// ReadUSDA reads the food, nutrient and food_nutrient CSV files of an
+// unpacked FoodData Central download and returns one record per food,
+// with values per 100 g.
+func ReadUSDA(dir string, opts USDAOptions) ([]config.IngredientRecord, error) {
+	types := opts.DataTypes
+	if len(types) == 0 {
+		types = []string{FoundationFood, SRLegacyFood}
+	}
+	wanted := make(map[string]bool, len(types))
+	for _, t := range types {
+		wanted[t] = true
+	}
+
+	nutrients, err := readFDCNutrients(filepath.Join(dir, "nutrient.csv"))
+	if err != nil {
+		return nil, err
+	}
+	foods, order, err := readFDCFoods(filepath.Join(dir, "food.csv"), wanted)
+	if err != nil {
+		return nil, err
+	}
+	if err := readFDCAmounts(filepath.Join(dir, "food_nutrient.csv"), foods); err != nil {
+		return nil, err
+	}
+
+	records := make([]config.IngredientRecord, 0, len(order))
+	names := make(map[string]bool, len(order))
+	for _, id := range order {
+		rec, ok := toRecord(id, foods[id], nutrients, opts.NutrientUnits)
+		if !ok {
+			continue
+		}
+		// Foundation and SR Legacy share some descriptions. Names are
+		// unique in the catalogue, so later foods get their ID appended.
+		if key := strings.ToLower(rec.Name); names[key] {
+			rec.Name = fmt.Sprintf("%s (FDC %d)", rec.Name, id)
+		}
+		names[strings.ToLower(rec.Name)] = true
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func toRecord(id int, food *fdcFood, nutrients map[int]string, units map[string]string) (config.IngredientRecord, bool) {
+	rec := config.IngredientRecord{
+		Name:   cleanDescription(food.description),
+		Source: "usda:" + strings.TrimSuffix(food.dataType, "_food"),
+		FDCID:  id,
+	}
+
+	found := false
+	for _, target := range fdcNutrients {
+		unit, isMacro := macroUnits[target.key]
+		if !isMacro {
+			if unit = units[target.key]; unit == "" {
+				continue
+			}
+		}
+		value, ok := pickAmount(food.amounts, target.ids, nutrients, unit)
+		if !ok {
+			continue
+		}
+		value = math.Round(value*1000) / 1000
+
+		switch target.key {
+		case "calories":
+			rec.Calories = value
+		case "proteins":
+			rec.Proteins = value
+		case "carbs":
+			rec.Carbs = value
+		case "fats":
+			rec.Fats = value
+		case "fiber":
+			rec.Fiber = value
+		default:
+			if rec.Nutrients == nil {
+				rec.Nutrients = make(config.Nutrients)
+			}
+			rec.Nutrients[target.key] = value
+		}
+		if isMacro {
+			found = true
+		}
+	}
+	// Foods without any energy or macro value are lab samples or
+	// placeholders and carry nothing the catalogue can use.
+	return rec, found
+}
+
+// pickAmount returns the first of ids the food reports, converted to unit.
+func pickAmount(amounts map[int]float64, ids []int, nutrients map[int]string, unit string) (float64, bool) {
+	for _, id := range ids {
+		amount, ok := amounts[id]
+		if !ok {
+			continue
+		}
+		value, err := convertUnit(amount, nutrients[id], unit, id)
+		if err == nil {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// convertUnit converts between the FDC unit names (G, MG, UG, KCAL, kJ,
+// IU) and the catalogue units (g, mg, µg, kcal).
+func convertUnit(amount float64, from, to string, nutrientID int) (float64, error) {
+	grams := map[string]float64{"g": 1, "mg": 1e-3, "ug": 1e-6, "µg": 1e-6}
+	from, to = strings.ToLower(from), strings.ToLower(to)
+
+	switch {
+	case from == to:
+		return amount, nil
+	case from == "kj" && to == "kcal":
+		return amount / 4.184, nil
+	case from == "iu" && nutrientID == 1110:
+		return convertUnit(amount*vitaminDPerIU, "ug", to, nutrientID)
+	}
+	f, okFrom := grams[from]
+	t, okTo := grams[to]
+	if !okFrom || !okTo {
+		return 0, fmt.Errorf("%w: %s to %s", ErrUnsupportedUnit, from, to)
+	}
+	return amount * f / t, nil
+}
+
+// cleanDescription trims FDC descriptions and drops characters the
+// catalogue does not allow in names.
+func cleanDescription(description string) string {
+	replacer := strings.NewReplacer(`"`, "", ";", ",", ":", " -", "+", " and ", "[", "(", "]", ")")
+	return strings.Join(strings.Fields(replacer.Replace(description)), " ")
+}
+
+// readFDCNutrients returns the unit of each FDC nutrient ID.
+func readFDCNutrients(path string) (map[int]string, error) {
+	nutrients := make(map[int]string)
+	err := eachCSVRow(path, []string{"id", "unit_name"}, func(row map[string]string) error {
+		id, err := strconv.Atoi(row["id"])
+		if err != nil {
+			return fmt.Errorf("invalid nutrient id %q", row["id"])
+		}
+		nutrients[id] = row["unit_name"]
+		return nil
+	})
+	return nutrients, err
+}
+
+func readFDCFoods(path string, wanted map[string]bool) (map[int]*fdcFood, []int, error) {
+	foods := make(map[int]*fdcFood)
+	var order []int
+	err := eachCSVRow(path, []string{"fdc_id", "data_type", "description"}, func(row map[string]string) error {
+		if !wanted[row["data_type"]] {
+			return nil
+		}
+		id, err := strconv.Atoi(row["fdc_id"])
+		if err != nil {
+			return fmt.Errorf("invalid fdc_id %q", row["fdc_id"])
+		}
+		if _, dup := foods[id]; !dup {
+			order = append(order, id)
+		}
+		foods[id] = &fdcFood{description: row["description"], dataType: row["data_type"], amounts: make(map[int]float64)}
+		return nil
+	})
+	return foods, order, err
+}
+
+func readFDCAmounts(path string, foods map[int]*fdcFood) error {
+	wanted := make(map[int]bool)
+	for _, target := range fdcNutrients {
+		for _, id := range target.ids {
+			wanted[id] = true
+		}
+	}
+
+	return eachCSVRow(path, []string{"fdc_id", "nutrient_id", "amount"}, func(row map[string]string) error {
+		fdcID, err := strconv.Atoi(row["fdc_id"])
+		if err != nil {
+			return fmt.Errorf("invalid fdc_id %q", row["fdc_id"])
+		}
+		food, ok := foods[fdcID]
+		if !ok {
+			return nil
+		}
+		nutrientID, err := strconv.Atoi(row["nutrient_id"])
+		if err != nil || !wanted[nutrientID] || row["amount"] == "" {
+			return nil
+		}
+		amount, err := strconv.ParseFloat(row["amount"], 64)
+		if err != nil {
+			return fmt.Errorf("invalid amount %q for food %d", row["amount"], fdcID)
+		}
+		food.amounts[nutrientID] = amount
+		return nil
+	})
+}
+
+// eachCSVRow streams a CSV file with a header row, passing each row to fn
+// as a map from column name to value.
+func eachCSVRow(path string, required []string, fn func(row map[string]string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return readCSVRows(f, filepath.Base(path), required, fn)
+}
+
+// readCSVRows is eachCSVRow for an open reader. Files whose header has a
+// tab are read as tab-separated, as in the Open Food Facts export.
+func readCSVRows(r io.Reader, name string, required []string, fn func(row map[string]string) error) error {
+	// Some FDC downloads start with a byte order mark, which the CSV
+	// reader would take as part of a quoted header field.
+	buf := bufio.NewReader(r)
+	if bom, err := buf.Peek(3); err == nil && string(bom) == "\ufeff" {
+		buf.Discard(3)
+	}
+	reader := csv.NewReader(buf)
+	reader.ReuseRecord = true
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	head, _ := buf.Peek(buf.Size())
+	if end := bytes.IndexByte(head, '\n'); end >= 0 {
+		head = head[:end]
+	}
+	if bytes.IndexByte(head, '\t') >= 0 {
+		reader.Comma = '\t'
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%s: reading header: %w", name, err)
+	}
+	columns := make([]string, len(header))
+	present := make(map[string]bool, len(header))
+	for i, col := range header {
+		columns[i] = strings.TrimSpace(col)
+		present[columns[i]] = true
+	}
+	for _, col := range required {
+		if !present[col] {
+			return fmt.Errorf("%s: missing column %q", name, col)
+		}
+	}
+
+	row := make(map[string]string, len(columns))
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		for i, col := range columns {
+			if i < len(record) {
+				row[col] = strings.TrimSpace(record[i])
+			} else {
+				row[col] = ""
+			}
+		}
+		if err := fn(row); err != nil {
+			return fmt.Errorf("%s line %d: %w", name, line, err)
+		}
+	}
+}
@@ -54,6 +54,8 @@ def analyze_nutrition(ingredients: List[Dict], user_profile: Optional[Dict] = No
             goal_calories = tdee + 500
         else:
             goal_calories = tdee
+        # Same floor as the Go targets, so both report one calorie goal.
+        goal_calories = max(goal_calories, 1200)
     
     recommendations = []
     
@@ -0,0 +1,84 @@
+# Copyright (c) 2025 @drclcomputers. All rights reserved.
+#
+# This work is licensed under the terms of the MIT license.
+# For a copy, see <https://opensource.org/licenses/MIT>.
+
+# Long-lived worker for the Go backend. Reads one JSON request per line on
+# stdin ({"id", "method", "params"}) and writes one JSON response per line on
+# stdout ({"id", "result"} or {"id", "error"}). Exits when stdin is closed.
+
+import sys
+import json
+import os
+from analyzer import analyze_nutrition
+from recommender import RecipeRecommender
+
+MODEL_FILENAME = "recommender_model.joblib"
+MODEL_PATH = os.path.join(os.path.dirname(__file__), "model_artifacts", MODEL_FILENAME)
+
+recommender = None
+model_error = None
+
+
+def load_model():
+    global recommender, model_error
+    if not os.path.exists(MODEL_PATH):
+        model_error = f"Model not found at {MODEL_PATH}."
+        return
+    try:
+        rec = RecipeRecommender()
+        rec.load_model(MODEL_PATH)
+        recommender = rec
+    except Exception as e:
+        model_error = str(e)
+
+
+def health(params):
+    return {"status": "ok", "model_loaded": recommender is not None, "model_error": model_error}
+
+
+def recommend(params):
+    if recommender is None:
+        raise RuntimeError(model_error or "Model not loaded")
+    ingredients = [str(ing).strip() for ing in params.get("ingredients", []) if str(ing).strip()]
+    if not ingredients:
+        raise ValueError("No valid ingredients provided.")
+    return recommender.get_recommendations(ingredients, params.get("top_k", 5))
+
+
+def analyze(params):
+    return analyze_nutrition(params.get("ingredients", []), params.get("user_profile"))
+
+
+METHODS = {
+    "health": health,
+    "recommend": recommend,
+    "analyze": analyze,
+}
+
+
+def main():
+    load_model()
+
+    for line in sys.stdin:
+        line = line.strip()
+        if not line:
+            continue
+
+        request_id = None
+        try:
+            request = json.loads(line)
+            request_id = request.get("id")
+            method = METHODS.get(request.get("method"))
+            if method is None:
+                raise ValueError(f"Unknown method: {request.get('method')}")
+            response = {"id": request_id, "result": method(request.get("params") or {})}
+        except Exception as e:
+            response = {"id": request_id, "error": str(e)}
+
+        sys.stdout.write(json.dumps(response) + "\n")
+        sys.stdout.flush()
+
+
+if __name__ == '__main__':
+    main()
@@ -0,0 +1,201 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package nutrition
+
+import (
+	"FoodStats/internal/config"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Analyze scores a meal the same way analyzer.py does; the rules, the
+// constants and the rounding are kept in step with the Python version.
+func Analyze(ingredients []config.Ingredient, profile *config.UserProfile) config.NutritionAnalysis {
+	var calories, proteins, carbs, fats, fiber float64
+	for _, ing := range ingredients {
+		calories += ing.Calories
+		proteins += ing.Proteins
+		carbs += ing.Carbs
+		fats += ing.Fats
+		fiber += ing.Fiber
+	}
+
+	energyShare := func(grams, kcalPerGram float64) float64 {
+		if calories > 0 {
+			return grams * kcalPerGram / calories
+		}
+		return 0
+	}
+	carbRatio := energyShare(carbs, kcalPerGramCarb)
+	fatRatio := energyShare(fats, kcalPerGramFat)
+
+	proteinRatio := math.Min(energyShare(proteins, kcalPerGramProtein), 1) * 30
+	fiberScore := math.Min(fiber/30, 1) * 20
+	fatBalance := (1 - math.Abs(0.3-fatRatio)) * 25
+	carbBalance := (1 - math.Abs(0.5-carbRatio)) * 25
+	healthScore := proteinRatio + fiberScore + fatBalance + carbBalance
+
+	var tdee, goalCalories float64
+	if profile != nil {
+		bmr, _ := BMR(*profile, MifflinStJeor)
+		tdee = TDEE(bmr, profile.ActivityLevel)
+		goalCalories = GoalCalories(tdee, profile.Goal)
+	}
+
+	recommendations := make([]string, 0)
+	if proteins < 20 {
+		recommendations = append(recommendations, "Add lean protein sources like chicken, fish, or legumes")
+	} else if proteins > 100 {
+		recommendations = append(recommendations, "Consider reducing protein intake to maintain balance")
+	}
+
+	if carbRatio < 0.4 {
+		recommendations = append(recommendations, "Include more complex carbohydrates for sustained energy")
+	} else if carbRatio > 0.7 {
+		recommendations = append(recommendations, "Reduce carbohydrate ratio for better macronutrient balance")
+	}
+
+	if fatRatio < 0.2 {
+		recommendations = append(recommendations, "Include healthy fats from nuts, avocados, or olive oil")
+	} else if fatRatio > 0.35 {
+		recommendations = append(recommendations, "Consider reducing fat intake for heart health")
+	}
+
+	if fiber < 25 {
+		recommendations = append(recommendations, "Increase fiber intake with whole grains and vegetables")
+	}
+
+	if profile != nil {
+		recommendations = append(recommendations, profileRecommendations(ingredients, *profile, calories, fiber, goalCalories)...)
+	}
+
+	balance := map[string]float64{"Proteins": 0, "Carbs": 0, "Fats": 0}
+	if total := proteins + carbs + fats; total > 0 {
+		balance["Proteins"] = proteins / total
+		balance["Carbs"] = carbs / total
+		balance["Fats"] = fats / total
+	}
+
+	scores := make(map[string]float64, len(balance))
+	for nutrient, value := range balance {
+		r := optimalRanges[nutrient]
+		score := 1.0
+		if value < r[0] {
+			score = value / r[0]
+		} else if value > r[1] {
+			score = 1 - (value-r[1])/(1-r[1])
+		}
+		scores[nutrient] = roundHalfEven(score, 2)
+	}
+
+	userData := make(map[string]interface{})
+	if profile != nil {
+		mealPercentage := 0.0
+		if goalCalories > 0 {
+			mealPercentage = calories / goalCalories * 100
+		}
+		conflicts := false
+		for _, rec := range recommendations {
+			if strings.Contains(rec, "contains") {
+				conflicts = true
+				break
+			}
+		}
+		userData["daily_calorie_goal"] = math.RoundToEven(goalCalories)
+		userData["meal_percentage"] = roundHalfEven(mealPercentage, 1)
+		userData["tdee"] = math.RoundToEven(tdee)
+		userData["has_dietary_conflicts"] = conflicts
+	}
+
+	return config.NutritionAnalysis{
+		HealthScore:     roundHalfEven(healthScore, 1),
+		Recommendations: recommendations,
+		NutrientBalance: balance,
+		NutrientScores:  scores,
+		MetricsBreakdown: map[string]float64{
+			"protein_ratio": proteinRatio,
+			"fiber_score":   fiberScore,
+			"fat_balance":   fatBalance,
+			"carb_balance":  carbBalance,
+		},
+		UserData: userData,
+	}
+}
+
+var optimalRanges = map[string][2]float64{
+	"Proteins": {0.25, 0.35},
+	"Carbs":    {0.45, 0.65},
+	"Fats":     {0.20, 0.35},
+}
+
+// restrictionKeywords flags ingredients whose whole name matches one of the
+// listed words, mirroring the keyword lists in analyzer.py.
+var restrictionKeywords = []struct {
+	restriction string
+	keywords    []string
+	message     string
+}{
+	{"vegan", []string{"meat", "chicken", "beef", "pork", "fish", "egg", "milk", "cheese", "yogurt"}, "This recipe contains non-vegan ingredients"},
+	{"vegetarian", []string{"meat", "chicken", "beef", "pork", "fish"}, "This recipe contains non-vegetarian ingredients"},
+	{"gluten_free", []string{"wheat", "barley", "rye", "bread", "pasta", "flour"}, "This recipe may contain gluten"},
+	{"dairy_free", []string{"milk", "cheese", "yogurt", "butter", "cream"}, "This recipe contains dairy products"},
+}
+
+func profileRecommendations(ingredients []config.Ingredient, profile config.UserProfile, calories, fiber, goalCalories float64) []string {
+	var recs []string
+
+	if goalCalories > 0 {
+		percentage := calories / goalCalories * 100
+		if percentage < 85 {
+			recs = append(recs, fmt.Sprintf("This meal provides only %.1f%% of your daily calorie goal (%.0f kcal)", percentage, goalCalories))
+		} else if percentage > 120 {
+			recs = append(recs, fmt.Sprintf("This meal exceeds %.1f%% of your daily calorie goal (%.0f kcal)", percentage, goalCalories))
+		}
+	}
+
+	if profile.Age > 50 && fiber < 30 {
+		recs = append(recs, "Adults over 50 need more fiber for digestive health")
+	}
+
+	if profile.Goal == "lose" && calories > goalCalories*0.4 {
+		recs = append(recs, fmt.Sprintf("For weight loss, consider smaller portions (aim for meals under %.0f calories)", goalCalories*0.4))
+	}
+
+	names := make(map[string]bool, len(ingredients))
+	for _, ing := range ingredients {
+		names[strings.ToLower(ing.Name)] = true
+	}
+	for _, rule := range restrictionKeywords {
+		if !hasRestriction(profile.DietaryRestrictions, rule.restriction) {
+			continue
+		}
+		for _, keyword := range rule.keywords {
+			if names[keyword] {
+				recs = append(recs, rule.message)
+				break
+			}
+		}
+	}
+	return recs
+}
+
+func hasRestriction(restrictions []string, name string) bool {
+	for _, r := range restrictions {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+// roundHalfEven matches Python's round(x, n), which rounds the exact binary
+// value and breaks true ties to even; strconv does the same.
+func roundHalfEven(x float64, digits int) float64 {
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(x, 'f', digits, 64), 64)
+	return v
+}
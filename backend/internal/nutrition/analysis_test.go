@@ -0,0 +1,108 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package nutrition
+
+import (
+	"FoodStats/internal/config"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// goldenCase is one file in testdata/analysis. Expected is what
+// internal/mls/analyzer.py printed for the same ingredients and profile:
+//
+//	python3 analyzer.py --data '<ingredients>' --user-profile '<profile>'
+type goldenCase struct {
+	Ingredients []config.Ingredient      `json:"ingredients"`
+	Profile     *config.UserProfile      `json:"profile"`
+	Expected    config.NutritionAnalysis `json:"expected"`
+}
+
+func TestAnalyzeMatchesPython(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "analysis", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no golden files in testdata/analysis")
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var golden goldenCase
+			if err := json.Unmarshal(data, &golden); err != nil {
+				t.Fatal(err)
+			}
+			want := golden.Expected
+			got := Analyze(golden.Ingredients, golden.Profile)
+
+			// Rounded values must match exactly, since the rounding is
+			// what the port has to get right.
+			if got.HealthScore != want.HealthScore {
+				t.Errorf("health_score = %v, want %v", got.HealthScore, want.HealthScore)
+			}
+			if !reflect.DeepEqual(got.NutrientScores, want.NutrientScores) {
+				t.Errorf("nutrient_scores = %v, want %v", got.NutrientScores, want.NutrientScores)
+			}
+			if !reflect.DeepEqual(got.Recommendations, want.Recommendations) {
+				t.Errorf("recommendations differ\n got: %q\nwant: %q", got.Recommendations, want.Recommendations)
+			}
+			if !reflect.DeepEqual(got.UserData, want.UserData) {
+				t.Errorf("user_data = %v, want %v", got.UserData, want.UserData)
+			}
+
+			compareFloats(t, "nutrient_balance", got.NutrientBalance, want.NutrientBalance)
+			compareFloats(t, "metrics_breakdown", got.MetricsBreakdown, want.MetricsBreakdown)
+		})
+	}
+}
+
+func TestRoundHalfEven(t *testing.T) {
+	tests := []struct {
+		x      float64
+		digits int
+		want   float64
+	}{
+		{30.25, 1, 30.2},
+		{31.25, 1, 31.2},
+		{0.125, 2, 0.12},
+		{0.375, 2, 0.38},
+		// 2.675 is stored just below the tie, so it rounds down.
+		{2.675, 2, 2.67},
+		{1930.5, 0, 1930},
+	}
+	for _, tt := range tests {
+		if got := roundHalfEven(tt.x, tt.digits); got != tt.want {
+			t.Errorf("roundHalfEven(%v, %d) = %v, want %v", tt.x, tt.digits, got, tt.want)
+		}
+	}
+}
+
+// compareFloats checks unrounded values, which may differ from Python's in
+// the last bits.
+func compareFloats(t *testing.T, field string, got, want map[string]float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s = %v, want %v", field, got, want)
+		return
+	}
+	for key, w := range want {
+		g, ok := got[key]
+		if !ok || math.Abs(g-w) > 1e-9 {
+			t.Errorf("%s[%s] = %v, want %v", field, key, g, w)
+		}
+	}
+}
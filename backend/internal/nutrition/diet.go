@@ -0,0 +1,186 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package nutrition
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/units"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Violation names an ingredient that breaks a dietary restriction or
+// allergy.
+type Violation struct {
+	Ingredient string `json:"ingredient"`
+	Rule       string `json:"rule"`
+}
+
+var (
+	meatWords      = []string{"meat", "chicken", "beef", "pork", "ham", "bacon", "sausage", "turkey", "lamb", "veal", "duck", "goose", "venison", "prosciutto", "pepperoni", "salami", "chorizo", "gelatin", "lard", "steak"}
+	fishWords      = []string{"fish", "salmon", "tuna", "cod", "trout", "halibut", "tilapia", "mackerel", "sardine", "anchovy", "anchovies", "haddock", "herring"}
+	shellfishWords = []string{"shrimp", "prawn", "crab", "lobster", "clam", "mussel", "oyster", "scallop", "squid", "octopus", "crayfish"}
+	dairyWords     = []string{"milk", "cheese", "cheddar", "parmesan", "mozzarella", "feta", "ricotta", "brie", "gouda", "yogurt", "yoghurt", "butter", "cream", "whey", "ghee", "custard", "buttermilk", "kefir", "mascarpone"}
+	eggWords       = []string{"egg", "mayonnaise", "meringue"}
+	glutenWords    = []string{"wheat", "barley", "rye", "bread", "pasta", "flour", "couscous", "spaghetti", "noodle", "bulgur", "seitan", "semolina", "breadcrumb", "cracker", "macaroni", "lasagna", "bagel", "muffin", "croissant", "pita", "malt", "farro", "spelt"}
+	treeNutWords   = []string{"almond", "walnut", "pecan", "cashew", "pistachio", "hazelnut", "macadamia", "nut"}
+	peanutWords    = []string{"peanut"}
+	soyWords       = []string{"soy", "soya", "tofu", "tempeh", "edamame", "miso"}
+	sesameWords    = []string{"sesame", "tahini"}
+
+	plantDairy    = []string{"coconut milk", "almond milk", "soy milk", "oat milk", "rice milk", "cashew milk", "peanut butter", "almond butter", "cashew butter", "cocoa butter", "coconut cream", "cream of tartar", "vegan"}
+	glutenFreeAlt = []string{"rice flour", "almond flour", "coconut flour", "corn flour", "chickpea flour", "rice noodle", "gluten-free", "gluten free"}
+)
+
+type dietRule struct {
+	words   [][]string
+	allowed []string
+}
+
+var dietRules = map[string]dietRule{
+	"vegetarian":  {words: [][]string{meatWords, fishWords, shellfishWords}},
+	"vegan":       {words: [][]string{meatWords, fishWords, shellfishWords, dairyWords, eggWords, {"honey"}}, allowed: plantDairy},
+	"gluten_free": {words: [][]string{glutenWords}, allowed: glutenFreeAlt},
+	"dairy_free":  {words: [][]string{dairyWords}, allowed: plantDairy},
+	"nut_free":    {words: [][]string{treeNutWords, peanutWords}},
+}
+
+// allergenRules maps common allergy names, including the EU and US major
+// allergen groups, onto word lists. Unknown allergies match by name.
+var allergenRules = map[string]dietRule{
+	"milk":      dietRules["dairy_free"],
+	"dairy":     dietRules["dairy_free"],
+	"lactose":   dietRules["dairy_free"],
+	"egg":       {words: [][]string{eggWords}},
+	"gluten":    dietRules["gluten_free"],
+	"wheat":     dietRules["gluten_free"],
+	"nut":       {words: [][]string{treeNutWords}},
+	"tree_nut":  {words: [][]string{treeNutWords}},
+	"peanut":    {words: [][]string{peanutWords}},
+	"fish":      {words: [][]string{fishWords}},
+	"shellfish": {words: [][]string{shellfishWords}},
+	"soy":       {words: [][]string{soyWords}},
+	"soya":      {words: [][]string{soyWords}},
+	"sesame":    {words: [][]string{sesameWords}},
+}
+
+// taxonomyRules answers the same restrictions from ingredient metadata.
+var taxonomyRules = map[string]func(config.IngredientInfo) bool{
+	"vegetarian":  func(i config.IngredientInfo) bool { return i.ContainsMeat },
+	"vegan":       func(i config.IngredientInfo) bool { return i.AnimalDerived },
+	"gluten_free": func(i config.IngredientInfo) bool { return i.ContainsGluten },
+	"dairy_free":  func(i config.IngredientInfo) bool { return hasAllergen(i, "milk") },
+	"nut_free":    func(i config.IngredientInfo) bool { return hasAllergen(i, "tree_nuts", "peanuts") },
+}
+
+// allergenKeys maps allergy names onto the allergen groups of the
+// taxonomy. Lactose is answered by the lactose flag instead.
+var allergenKeys = map[string][]string{
+	"milk":       {"milk"},
+	"dairy":      {"milk"},
+	"egg":        {"eggs"},
+	"gluten":     {"gluten"},
+	"wheat":      {"wheat"},
+	"nut":        {"tree_nuts"},
+	"tree_nut":   {"tree_nuts"},
+	"peanut":     {"peanuts"},
+	"fish":       {"fish"},
+	"shellfish":  {"crustaceans", "molluscs"},
+	"crustacean": {"crustaceans"},
+	"mollusc":    {"molluscs"},
+	"soy":        {"soybeans"},
+	"soya":       {"soybeans"},
+	"soybean":    {"soybeans"},
+	"sesame":     {"sesame"},
+	"celery":     {"celery"},
+	"mustard":    {"mustard"},
+	"sulphite":   {"sulphites"},
+	"sulfite":    {"sulphites"},
+	"lupin":      {"lupin"},
+}
+
+// CheckDiet returns every ingredient that breaks one of the restrictions
+// or allergies. Ingredients found in the taxonomy, keyed by lower-cased
+// name, are judged by their metadata. The rest are matched word by word,
+// so "eggplant" is not an egg and "peanut butter" is not dairy.
+func CheckDiet(ingredients, restrictions, allergies []string, taxonomy map[string]config.IngredientInfo) []Violation {
+	var violations []Violation
+	for _, name := range ingredients {
+		info, known := taxonomy[strings.ToLower(name)]
+		for _, restriction := range restrictions {
+			if known {
+				if check, ok := taxonomyRules[restriction]; ok {
+					if check(info) {
+						violations = append(violations, Violation{Ingredient: name, Rule: restriction})
+					}
+					continue
+				}
+			}
+			if rule, ok := dietRules[restriction]; ok && rule.matches(name) {
+				violations = append(violations, Violation{Ingredient: name, Rule: restriction})
+			}
+		}
+		for _, allergy := range allergies {
+			key := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(allergy)), " ", "_")
+			if forms := units.SingularCandidates(key); len(forms) > 0 {
+				key = forms[0]
+			}
+			if key == "" {
+				continue
+			}
+			if known {
+				if keys, ok := allergenKeys[key]; ok || key == "lactose" {
+					if hasAllergen(info, keys...) || (key == "lactose" && info.ContainsLactose) {
+						violations = append(violations, Violation{Ingredient: name, Rule: "allergy:" + allergy})
+					}
+					continue
+				}
+			}
+			rule, ok := allergenRules[key]
+			if !ok {
+				rule = dietRule{words: [][]string{{key}}}
+			}
+			if rule.matches(name) {
+				violations = append(violations, Violation{Ingredient: name, Rule: "allergy:" + allergy})
+			}
+		}
+	}
+	return violations
+}
+
+func hasAllergen(info config.IngredientInfo, keys ...string) bool {
+	for _, a := range info.Allergens {
+		for _, k := range keys {
+			if a == k {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r dietRule) matches(name string) bool {
+	name = strings.ToLower(name)
+	for _, phrase := range r.allowed {
+		if strings.Contains(name, phrase) {
+			return false
+		}
+	}
+
+	words := strings.FieldsFunc(name, func(c rune) bool { return !unicode.IsLetter(c) })
+	for _, word := range words {
+		forms := units.SingularCandidates(word)
+		for _, list := range r.words {
+			for _, w := range list {
+				if word == w || slices.Contains(forms, w) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
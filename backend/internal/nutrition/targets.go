@@ -0,0 +1,160 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package nutrition
+
+import (
+	"FoodStats/internal/config"
+	"errors"
+	"fmt"
+	"math"
+)
+
+type Formula string
+
+const (
+	MifflinStJeor  Formula = "mifflin_st_jeor"
+	HarrisBenedict Formula = "harris_benedict"
+	KatchMcArdle   Formula = "katch_mcardle"
+)
+
+var (
+	ErrUnknownFormula  = errors.New("unknown BMR formula")
+	ErrBodyFatRequired = errors.New("the Katch-McArdle formula needs a body fat percentage")
+)
+
+var ActivityFactors = map[string]float64{
+	"sedentary":   1.2,
+	"light":       1.375,
+	"moderate":    1.55,
+	"active":      1.725,
+	"very_active": 1.9,
+}
+
+var GoalAdjustments = map[string]float64{
+	"lose":     -500,
+	"maintain": 0,
+	"gain":     500,
+}
+
+var proteinPerKg = map[string]float64{
+	"lose":     1.6,
+	"maintain": 1.2,
+	"gain":     1.6,
+}
+
+const (
+	minCalories        = 1200
+	fatShare           = 0.30
+	maxProteinShare    = 0.35
+	fiberPer1000kcal   = 14
+	addedSugarShare    = 0.10
+	saturatedFatShare  = 0.10
+	sodiumLimitMg      = 2300
+	kcalPerGramProtein = 4
+	kcalPerGramCarb    = 4
+	kcalPerGramFat     = 9
+)
+
+func ParseFormula(name string) (Formula, error) {
+	switch Formula(name) {
+	case "", MifflinStJeor:
+		return MifflinStJeor, nil
+	case HarrisBenedict, KatchMcArdle:
+		return Formula(name), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownFormula, name)
+	}
+}
+
+func BMR(profile config.UserProfile, formula Formula) (float64, error) {
+	w, h, a := profile.Weight, profile.Height, float64(profile.Age)
+
+	switch formula {
+	case MifflinStJeor:
+		base := 10*w + 6.25*h - 5*a
+		switch profile.Gender {
+		case "male":
+			return base + 5, nil
+		case "female":
+			return base - 161, nil
+		default:
+			return base - 78, nil
+		}
+	case HarrisBenedict:
+		male := 88.362 + 13.397*w + 4.799*h - 5.677*a
+		female := 447.593 + 9.247*w + 3.098*h - 4.330*a
+		switch profile.Gender {
+		case "male":
+			return male, nil
+		case "female":
+			return female, nil
+		default:
+			return (male + female) / 2, nil
+		}
+	case KatchMcArdle:
+		if profile.BodyFat <= 0 {
+			return 0, ErrBodyFatRequired
+		}
+		leanMass := w * (1 - profile.BodyFat/100)
+		return 370 + 21.6*leanMass, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnknownFormula, formula)
+	}
+}
+
+func ActivityFactor(level string) float64 {
+	if factor, ok := ActivityFactors[level]; ok {
+		return factor
+	}
+	return ActivityFactors["sedentary"]
+}
+
+func TDEE(bmr float64, activityLevel string) float64 {
+	return bmr * ActivityFactor(activityLevel)
+}
+
+// GoalCalories is the daily calorie goal, never below minCalories. Both
+// the targets and the meal analysis use it, as does analyzer.py.
+func GoalCalories(tdee float64, goal string) float64 {
+	return math.Max(tdee+GoalAdjustments[goal], minCalories)
+}
+
+func ComputeTargets(profile config.UserProfile, formula Formula) (config.NutritionTargets, error) {
+	bmr, err := BMR(profile, formula)
+	if err != nil {
+		return config.NutritionTargets{}, err
+	}
+	tdee := TDEE(bmr, profile.ActivityLevel)
+	calories := GoalCalories(tdee, profile.Goal)
+
+	perKg, ok := proteinPerKg[profile.Goal]
+	if !ok {
+		perKg = proteinPerKg["maintain"]
+	}
+	proteins := math.Min(perKg*profile.Weight, calories*maxProteinShare/kcalPerGramProtein)
+	fats := calories * fatShare / kcalPerGramFat
+	carbs := math.Max(0, (calories-proteins*kcalPerGramProtein-fats*kcalPerGramFat)/kcalPerGramCarb)
+
+	return config.NutritionTargets{
+		Formula:  string(formula),
+		BMR:      math.Round(bmr),
+		TDEE:     math.Round(tdee),
+		Calories: math.Round(calories),
+		Proteins: round1(proteins),
+		Carbs:    round1(carbs),
+		Fats:     round1(fats),
+		Fiber:    round1(calories / 1000 * fiberPer1000kcal),
+		Limits: map[string]float64{
+			"added_sugars":  round1(calories * addedSugarShare / kcalPerGramCarb),
+			"saturated_fat": round1(calories * saturatedFatShare / kcalPerGramFat),
+			"sodium":        sodiumLimitMg,
+		},
+	}, nil
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package planner
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"FoodStats/internal/nutrition"
+	"strings"
+)
+
+type mealKind uint8
+
+const (
+	kindBreakfast mealKind = 1 << iota
+	kindMain
+	kindSnack
+)
+
+// minServingCalories leaves out recipes whose ingredients are missing from
+// the catalogue, which would otherwise look like free food.
+const minServingCalories = 50
+
+// Recipes have no meal type, so it is guessed from words in the name.
+var (
+	breakfastWords = []string{"oat", "oatmeal", "porridge", "yogurt", "parfait", "omelette", "frittata",
+		"toast", "breakfast", "muffin", "pancake", "smoothie", "granola", "hash"}
+	// breakfastOnlyWords are not served as lunch or dinner.
+	breakfastOnlyWords = []string{"oat", "oats", "oatmeal", "porridge", "yogurt", "parfait", "breakfast",
+		"muffin", "pancake", "smoothie", "granola"}
+	snackWords = []string{"parfait", "yogurt", "toast", "hummus", "fries", "roasted", "salad", "soup",
+		"smoothie", "muffin"}
+)
+
+func classify(name string) mealKind {
+	words := strings.FieldsFunc(strings.ToLower(name), func(c rune) bool {
+		return c == ' ' || c == '-' || c == ','
+	})
+	has := func(list []string) bool {
+		for _, w := range words {
+			for _, l := range list {
+				if w == l || w == l+"s" {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	var kinds mealKind
+	if has(breakfastWords) {
+		kinds |= kindBreakfast
+	}
+	if !has(breakfastOnlyWords) {
+		kinds |= kindMain
+	}
+	if has(snackWords) {
+		kinds |= kindSnack
+	}
+	return kinds
+}
+
+func kindOf(meal string) mealKind {
+	switch meal {
+	case "breakfast":
+		return kindBreakfast
+	case "snack":
+		return kindSnack
+	default:
+		return kindMain
+	}
+}
+
+// LoadCandidates returns the recipes that fit the restrictions and
+// allergies, with their nutrition per serving.
+func LoadCandidates(restrictions, allergies []string) ([]Candidate, error) {
+	recipes, err := database.ListRecipesWithNutrition()
+	if err != nil {
+		return nil, err
+	}
+
+	var taxonomy map[string]config.IngredientInfo
+	if len(restrictions) > 0 || len(allergies) > 0 {
+		if taxonomy, err = database.IngredientTaxonomy(); err != nil {
+			return nil, err
+		}
+	}
+
+	var cands []Candidate
+	for _, recipe := range recipes {
+		if recipe.Nutrition == nil || recipe.Nutrition.PerServing.Calories < minServingCalories {
+			continue
+		}
+		names := make([]string, 0, len(recipe.Ingredients))
+		for _, ing := range recipe.Ingredients {
+			names = append(names, ing.Name)
+		}
+		if len(nutrition.CheckDiet(names, restrictions, allergies, taxonomy)) > 0 {
+			continue
+		}
+		cands = append(cands, Candidate{
+			ID:         recipe.ID,
+			Name:       recipe.Name,
+			PerServing: recipe.Nutrition.PerServing,
+			kinds:      classify(recipe.Name),
+		})
+	}
+	return cands, nil
+}
@@ -0,0 +1,489 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+// Package planner builds multi-day meal plans from the recipe collection.
+// Plans are filled by a randomised greedy pass and then improved by local
+// search, one meal at a time, against the day's calorie, macro and fiber
+// targets.
+package planner
+
+import (
+	"FoodStats/internal/config"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	DefaultDays       = 7
+	MaxDays           = 14
+	DefaultMaxRepeats = 2
+)
+
+var (
+	ErrNoCandidates  = errors.New("no recipes fit the plan's restrictions")
+	ErrNoAlternative = errors.New("no other recipe fits this meal")
+	ErrUnknownMeal   = errors.New("the plan has no such meal")
+)
+
+// DefaultMeals are the meals of a day in the order they are eaten.
+var DefaultMeals = []string{"breakfast", "lunch", "dinner", "snack"}
+
+// mealShares is the part of the day's calories each meal should provide,
+// before normalising over the meals in the plan.
+var mealShares = map[string]float64{
+	"breakfast": 0.25,
+	"lunch":     0.35,
+	"dinner":    0.30,
+	"snack":     0.10,
+}
+
+// portions are the servings a meal may be scaled to.
+var portions = []float64{0.5, 1, 1.5, 2, 2.5, 3}
+
+// tolerance is how far a day may be from each target and still count as
+// meeting it, as a share of the target. Fiber is a minimum.
+var tolerance = struct {
+	calories, proteins, carbs, fats, fiber float64
+}{0.10, 0.15, 0.20, 0.20, 0.10}
+
+// Cost weights. Missing the calorie target matters most; a meal far from
+// its share of the day and a recipe used more than once cost a little.
+const (
+	weightCalories   = 4
+	weightProteins   = 2
+	weightCarbs      = 1
+	weightFats       = 1
+	weightFiber      = 1
+	weightMealShare  = 0.5
+	mealShareSlack   = 0.35
+	outsidePenalty   = 10
+	repeatPenalty    = 0.05
+	greedyChoices    = 4
+	maxSearchSweeps  = 20
+	regenerateChoice = 3
+)
+
+// Candidate is a recipe the planner may use, with its nutrition per
+// serving.
+type Candidate struct {
+	ID         int
+	Name       string
+	PerServing config.NutritionalInfo
+	kinds      mealKind
+}
+
+type option struct {
+	candidate *Candidate
+	portion   float64
+}
+
+// state holds the plan being optimised. Locked meals are fixed and may use
+// recipes that are not candidates.
+type state struct {
+	plan    *config.MealPlan
+	cands   []*Candidate
+	shares  []float64
+	uses    map[int]int
+	rng     *rand.Rand
+	targets config.NutritionalInfo
+	relax   relaxLevel
+}
+
+// relaxLevel says which repeat rules rankOptions may break, for when the
+// candidates are too few to fill every meal otherwise.
+type relaxLevel int
+
+const (
+	relaxNone relaxLevel = iota
+	// relaxPlanRepeats lets a recipe appear more than MaxRepeats times.
+	relaxPlanRepeats
+	// relaxDayRepeats also lets a recipe appear twice in one day.
+	relaxDayRepeats
+)
+
+func newState(plan *config.MealPlan, cands []Candidate, rng *rand.Rand) *state {
+	s := &state{
+		plan:  plan,
+		uses:  make(map[int]int),
+		rng:   rng,
+		cands: make([]*Candidate, len(cands)),
+		targets: config.NutritionalInfo{
+			Calories: plan.Targets.Calories,
+			Proteins: plan.Targets.Proteins,
+			Carbs:    plan.Targets.Carbs,
+			Fats:     plan.Targets.Fats,
+			Fiber:    plan.Targets.Fiber,
+		},
+	}
+	for i := range cands {
+		if cands[i].kinds == 0 {
+			cands[i].kinds = classify(cands[i].Name)
+		}
+		s.cands[i] = &cands[i]
+	}
+
+	total := 0.0
+	for _, meal := range plan.Meals {
+		total += mealShares[meal]
+	}
+	for _, meal := range plan.Meals {
+		s.shares = append(s.shares, mealShares[meal]/total)
+	}
+
+	for _, day := range plan.Days {
+		for _, meal := range day.Meals {
+			if meal.RecipeID != 0 {
+				s.uses[meal.RecipeID]++
+			}
+		}
+	}
+	return s
+}
+
+// Fill plans every meal that is not locked. Days and meals missing from
+// plan.Days are created; locked meals and their nutrition are kept. When
+// there are too few candidates to stay within MaxRepeats, the meals that
+// cannot be filled otherwise repeat the least used recipes, so no meal is
+// left empty.
+func Fill(plan *config.MealPlan, cands []Candidate, rng *rand.Rand) error {
+	if len(cands) == 0 {
+		return ErrNoCandidates
+	}
+	normalizeDays(plan)
+	s := newState(plan, cands, rng)
+
+	// Unlocked meals are planned from scratch.
+	for d := range plan.Days {
+		for m := range plan.Days[d].Meals {
+			meal := &plan.Days[d].Meals[m]
+			if !meal.Locked && meal.RecipeID != 0 {
+				s.uses[meal.RecipeID]--
+				meal.RecipeID, meal.RecipeName, meal.Portion = 0, "", 0
+				meal.Nutrition = config.NutritionalInfo{}
+			}
+		}
+	}
+
+	for d := range plan.Days {
+		if !s.greedyDay(d) {
+			return ErrNoCandidates
+		}
+	}
+	s.localSearch()
+	Summarize(plan)
+	return nil
+}
+
+// Replace picks a different recipe for one meal and leaves the rest of the
+// plan alone. The choice is random among the few that fit the day best.
+func Replace(plan *config.MealPlan, day int, meal string, cands []Candidate, rng *rand.Rand) error {
+	d, m, ok := findMeal(plan, day, meal)
+	if !ok {
+		return ErrUnknownMeal
+	}
+	s := newState(plan, cands, rng)
+
+	current := plan.Days[d].Meals[m].RecipeID
+	s.uses[current]--
+	s.clear(d, m)
+
+	ranked := s.rankOptions(d, m, false, func(c *Candidate) bool { return c.ID != current })
+	if len(ranked) == 0 {
+		s.uses[current]++
+		return ErrNoAlternative
+	}
+	pick := ranked[s.rng.Intn(min(regenerateChoice, len(ranked)))]
+	s.assign(d, m, pick.option)
+	plan.Days[d].Meals[m].Locked = false
+	Summarize(plan)
+	return nil
+}
+
+// Summarize sets each day's total and whether it meets the targets.
+func Summarize(plan *config.MealPlan) {
+	for d := range plan.Days {
+		var total config.NutritionalInfo
+		for _, meal := range plan.Days[d].Meals {
+			total.Add(meal.Nutrition)
+		}
+		plan.Days[d].Total = total
+		plan.Days[d].WithinTargets = withinTargets(total, plan.Targets)
+	}
+}
+
+func withinTargets(total config.NutritionalInfo, t config.NutritionTargets) bool {
+	within := func(actual, target, tol float64) bool {
+		return target <= 0 || math.Abs(actual-target) <= target*tol
+	}
+	return within(total.Calories, t.Calories, tolerance.calories) &&
+		within(total.Proteins, t.Proteins, tolerance.proteins) &&
+		within(total.Carbs, t.Carbs, tolerance.carbs) &&
+		within(total.Fats, t.Fats, tolerance.fats) &&
+		(t.Fiber <= 0 || total.Fiber >= t.Fiber*(1-tolerance.fiber))
+}
+
+// normalizeDays makes sure every day has each of the plan's meals, in
+// order, keeping meals already planned.
+func normalizeDays(plan *config.MealPlan) {
+	for d := range plan.Days {
+		existing := make(map[string]config.PlannedMeal, len(plan.Days[d].Meals))
+		for _, meal := range plan.Days[d].Meals {
+			existing[meal.Meal] = meal
+		}
+		meals := make([]config.PlannedMeal, len(plan.Meals))
+		for i, name := range plan.Meals {
+			meal, ok := existing[name]
+			if !ok {
+				meal = config.PlannedMeal{Meal: name}
+			}
+			meals[i] = meal
+		}
+		plan.Days[d].Day = d + 1
+		plan.Days[d].Meals = meals
+	}
+}
+
+func findMeal(plan *config.MealPlan, day int, meal string) (int, int, bool) {
+	if day < 1 || day > len(plan.Days) {
+		return 0, 0, false
+	}
+	for m, planned := range plan.Days[day-1].Meals {
+		if planned.Meal == meal {
+			return day - 1, m, true
+		}
+	}
+	return 0, 0, false
+}
+
+// greedyDay fills the open meals of a day in order, each time choosing at
+// random among the options that best fit the part of the day planned so
+// far. A meal no candidate may fill within the repeat limits is filled by
+// relaxing them one step at a time. It reports false if a meal stays
+// empty.
+func (s *state) greedyDay(d int) bool {
+	defer func() { s.relax = relaxNone }()
+	for m, meal := range s.plan.Days[d].Meals {
+		if meal.RecipeID != 0 {
+			continue
+		}
+		var ranked []rankedOption
+		for s.relax = relaxNone; s.relax <= relaxDayRepeats; s.relax++ {
+			if ranked = s.rankOptions(d, m, true, nil); len(ranked) > 0 {
+				break
+			}
+		}
+		if len(ranked) == 0 {
+			return false
+		}
+		pick := ranked[s.rng.Intn(min(greedyChoices, len(ranked)))]
+		s.assign(d, m, pick.option)
+	}
+	return true
+}
+
+// localSearch revisits the unlocked meals in random order and moves each
+// to its best option while that lowers the cost of its day, until a full
+// sweep changes nothing.
+func (s *state) localSearch() {
+	type slot struct{ d, m int }
+	var slots []slot
+	for d, day := range s.plan.Days {
+		for m, meal := range day.Meals {
+			if !meal.Locked {
+				slots = append(slots, slot{d, m})
+			}
+		}
+	}
+
+	for sweep := 0; sweep < maxSearchSweeps; sweep++ {
+		s.rng.Shuffle(len(slots), func(i, j int) { slots[i], slots[j] = slots[j], slots[i] })
+		improved := false
+		for _, sl := range slots {
+			meal := s.plan.Days[sl.d].Meals[sl.m]
+			currentCost := s.dayCost(sl.d, -1, option{}) + s.repeatCost(meal.RecipeID, true)
+
+			saved := meal
+			s.uses[meal.RecipeID]--
+			s.clear(sl.d, sl.m)
+			ranked := s.rankOptions(sl.d, sl.m, false, nil)
+			if len(ranked) > 0 && ranked[0].cost < currentCost-1e-9 {
+				s.assign(sl.d, sl.m, ranked[0].option)
+				improved = true
+				continue
+			}
+			s.plan.Days[sl.d].Meals[sl.m] = saved
+			s.uses[saved.RecipeID]++
+		}
+		if !improved {
+			return
+		}
+	}
+}
+
+type rankedOption struct {
+	option
+	cost float64
+}
+
+// rankOptions scores every allowed recipe and portion for an empty meal,
+// best first. Recipes already in the day or used MaxRepeats times are
+// left out, unless s.relax allows them. With partial set, only the meals up to this one count and
+// the targets are scaled to their share of the day.
+func (s *state) rankOptions(d, m int, partial bool, allow func(*Candidate) bool) []rankedOption {
+	meal := s.plan.Days[d].Meals[m].Meal
+	used := make(map[int]bool)
+	for _, other := range s.plan.Days[d].Meals {
+		used[other.RecipeID] = true
+	}
+
+	pool := s.pool(meal)
+	allowed := func(c *Candidate) bool {
+		return (!used[c.ID] || s.relax >= relaxDayRepeats) &&
+			(s.uses[c.ID] < s.plan.MaxRepeats || s.relax >= relaxPlanRepeats) &&
+			(allow == nil || allow(c))
+	}
+	// Past the limits, only the least used recipes are repeated.
+	fewestUses := math.MaxInt
+	if s.relax > relaxNone {
+		for _, c := range pool {
+			if allowed(c) {
+				fewestUses = min(fewestUses, s.uses[c.ID])
+			}
+		}
+	}
+
+	var ranked []rankedOption
+	for _, c := range pool {
+		if !allowed(c) || s.uses[c.ID] > fewestUses {
+			continue
+		}
+		for _, portion := range portions {
+			opt := option{c, portion}
+			var cost float64
+			if partial {
+				cost = s.partialCost(d, m, opt)
+			} else {
+				cost = s.dayCost(d, m, opt)
+			}
+			cost += s.repeatCost(c.ID, false)
+			ranked = append(ranked, rankedOption{opt, cost})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].cost != ranked[j].cost {
+			return ranked[i].cost < ranked[j].cost
+		}
+		return ranked[i].candidate.ID < ranked[j].candidate.ID
+	})
+	return ranked
+}
+
+// pool returns the candidates suited to a meal, or all of them when none
+// are.
+func (s *state) pool(meal string) []*Candidate {
+	kind := kindOf(meal)
+	var pool []*Candidate
+	for _, c := range s.cands {
+		if c.kinds&kind != 0 {
+			pool = append(pool, c)
+		}
+	}
+	if len(pool) == 0 {
+		return s.cands
+	}
+	return pool
+}
+
+func (s *state) assign(d, m int, opt option) {
+	meal := &s.plan.Days[d].Meals[m]
+	meal.RecipeID = opt.candidate.ID
+	meal.RecipeName = opt.candidate.Name
+	meal.Portion = opt.portion
+	meal.Nutrition = opt.candidate.PerServing.Scaled(opt.portion)
+	s.uses[opt.candidate.ID]++
+}
+
+func (s *state) clear(d, m int) {
+	meal := &s.plan.Days[d].Meals[m]
+	meal.RecipeID, meal.RecipeName, meal.Portion = 0, "", 0
+	meal.Nutrition = config.NutritionalInfo{}
+}
+
+// repeatCost is the penalty for using a recipe once more, or with
+// existing set, for the use already in the plan.
+func (s *state) repeatCost(id int, existing bool) float64 {
+	n := s.uses[id]
+	if existing {
+		n--
+	}
+	return repeatPenalty * float64(n)
+}
+
+// dayCost is the cost of day d with meal m replaced by opt, or as it is
+// when m is negative.
+func (s *state) dayCost(d, m int, opt option) float64 {
+	var total config.NutritionalInfo
+	cost := 0.0
+	for i, meal := range s.plan.Days[d].Meals {
+		info := meal.Nutrition
+		if i == m {
+			info = opt.candidate.PerServing.Scaled(opt.portion)
+		}
+		total.Add(info)
+		cost += s.mealShareCost(i, info.Calories)
+	}
+	return cost + targetCost(total, s.targets, 1)
+}
+
+// partialCost compares the meals up to m, with m set to opt, against their
+// share of the day's targets.
+func (s *state) partialCost(d, m int, opt option) float64 {
+	var total config.NutritionalInfo
+	share := 0.0
+	cost := 0.0
+	for i := 0; i <= m; i++ {
+		info := s.plan.Days[d].Meals[i].Nutrition
+		if i == m {
+			info = opt.candidate.PerServing.Scaled(opt.portion)
+		}
+		total.Add(info)
+		share += s.shares[i]
+		cost += s.mealShareCost(i, info.Calories)
+	}
+	return cost + targetCost(total, s.targets, share)
+}
+
+func (s *state) mealShareCost(i int, calories float64) float64 {
+	want := s.targets.Calories * s.shares[i]
+	if want <= 0 {
+		return 0
+	}
+	off := math.Max(0, math.Abs(calories-want)/want-mealShareSlack)
+	return weightMealShare * off * off
+}
+
+// targetCost grows with the squared relative distance from each target and
+// much faster once a value leaves its tolerance.
+func targetCost(total, targets config.NutritionalInfo, share float64) float64 {
+	term := func(actual, target, tol, weight float64, minimum bool) float64 {
+		target *= share
+		if target <= 0 {
+			return 0
+		}
+		rel := (actual - target) / target
+		if minimum && rel > 0 {
+			return 0
+		}
+		outside := math.Max(0, math.Abs(rel)-tol)
+		return weight * (rel*rel + outsidePenalty*outside*outside)
+	}
+	return term(total.Calories, targets.Calories, tolerance.calories, weightCalories, false) +
+		term(total.Proteins, targets.Proteins, tolerance.proteins, weightProteins, false) +
+		term(total.Carbs, targets.Carbs, tolerance.carbs, weightCarbs, false) +
+		term(total.Fats, targets.Fats, tolerance.fats, weightFats, false) +
+		term(total.Fiber, targets.Fiber, tolerance.fiber, weightFiber, true)
+}
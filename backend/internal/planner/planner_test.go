@@ -0,0 +1,138 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package planner
+
+import (
+	"FoodStats/internal/config"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func testPlan(days, maxRepeats int) *config.MealPlan {
+	return &config.MealPlan{
+		Meals:      DefaultMeals,
+		MaxRepeats: maxRepeats,
+		Targets:    config.NutritionTargets{Calories: 2000, Proteins: 100, Carbs: 250, Fats: 65, Fiber: 30},
+		Days:       make([]config.PlanDay, days),
+	}
+}
+
+func testCandidates(names ...string) []Candidate {
+	cands := make([]Candidate, len(names))
+	for i, name := range names {
+		cands[i] = Candidate{
+			ID:         i + 1,
+			Name:       name,
+			PerServing: config.NutritionalInfo{Calories: 400, Proteins: 20, Carbs: 50, Fats: 13, Fiber: 6},
+		}
+	}
+	return cands
+}
+
+// checkFilled fails for any meal left without a recipe, which the
+// database would refuse to save.
+func checkFilled(t *testing.T, plan *config.MealPlan) {
+	t.Helper()
+	for _, day := range plan.Days {
+		for _, meal := range day.Meals {
+			if meal.RecipeID == 0 || meal.Portion <= 0 {
+				t.Errorf("day %d %s is empty: %+v", day.Day, meal.Meal, meal)
+			}
+		}
+	}
+}
+
+func TestFillKeepsRepeatLimit(t *testing.T) {
+	var names []string
+	for i := 0; i < 30; i++ {
+		names = append(names, fmt.Sprintf("Stew %d", i))
+	}
+	plan := testPlan(7, DefaultMaxRepeats)
+	if err := Fill(plan, testCandidates(names...), rand.New(rand.NewSource(1))); err != nil {
+		t.Fatal(err)
+	}
+	checkFilled(t, plan)
+
+	uses := make(map[int]int)
+	for _, day := range plan.Days {
+		today := make(map[int]bool)
+		for _, meal := range day.Meals {
+			if today[meal.RecipeID] {
+				t.Errorf("day %d repeats %s", day.Day, meal.RecipeName)
+			}
+			today[meal.RecipeID] = true
+			uses[meal.RecipeID]++
+		}
+	}
+	for id, n := range uses {
+		if n > DefaultMaxRepeats {
+			t.Errorf("recipe %d used %d times, limit is %d", id, n, DefaultMaxRepeats)
+		}
+	}
+}
+
+func TestFillFewCandidates(t *testing.T) {
+	// The names fit no meal type, so every recipe may fill every meal.
+	tests := []struct {
+		name  string
+		days  int
+		names []string
+	}{
+		// 28 meals from 5 recipes cannot keep to two uses each.
+		{"below repeat limit", 7, []string{"Lentil Stew", "Chickpea Curry", "Tofu Stir Fry", "Bean Chili", "Veggie Burrito"}},
+		// Fewer recipes than meals in a day.
+		{"below meals per day", 3, []string{"Lentil Stew", "Bean Chili"}},
+		{"single recipe", 2, []string{"Lentil Stew"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cands := testCandidates(tt.names...)
+			plan := testPlan(tt.days, DefaultMaxRepeats)
+			if err := Fill(plan, cands, rand.New(rand.NewSource(1))); err != nil {
+				t.Fatal(err)
+			}
+			checkFilled(t, plan)
+
+			// The least used recipes are repeated, so uses stay even.
+			uses := make(map[int]int)
+			for _, day := range plan.Days {
+				for _, meal := range day.Meals {
+					uses[meal.RecipeID]++
+				}
+			}
+			meals := tt.days * len(DefaultMeals)
+			if most := (meals + len(cands) - 1) / len(cands); len(uses) != len(cands) {
+				t.Errorf("used %d of %d recipes", len(uses), len(cands))
+			} else {
+				for id, n := range uses {
+					if n > most+1 {
+						t.Errorf("recipe %d used %d times, expected about %d", id, n, most)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFillNoCandidates(t *testing.T) {
+	if err := Fill(testPlan(1, DefaultMaxRepeats), nil, rand.New(rand.NewSource(1))); err != ErrNoCandidates {
+		t.Fatalf("Fill = %v, want %v", err, ErrNoCandidates)
+	}
+}
+
+func TestFillKeepsLockedMeals(t *testing.T) {
+	cands := testCandidates("Lentil Soup", "Chickpea Curry", "Tofu Stir Fry", "Bean Chili", "Oatmeal")
+	plan := testPlan(2, DefaultMaxRepeats)
+	plan.Days[0].Meals = []config.PlannedMeal{{Meal: "lunch", RecipeID: 99, RecipeName: "Pasta", Portion: 1, Locked: true}}
+	if err := Fill(plan, cands, rand.New(rand.NewSource(1))); err != nil {
+		t.Fatal(err)
+	}
+	checkFilled(t, plan)
+	if lunch := plan.Days[0].Meals[1]; lunch.RecipeID != 99 || !lunch.Locked {
+		t.Errorf("locked lunch replaced by %+v", lunch)
+	}
+}
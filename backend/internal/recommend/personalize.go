@@ -0,0 +1,218 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package recommend
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"FoodStats/internal/nutrition"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	mealsPerDay      = 3
+	similarityWeight = 0.6
+	fitWeight        = 0.4
+)
+
+// Preferences describe what the user can eat and what is left of their
+// day. Targets is nil when the user has no profile.
+type Preferences struct {
+	Restrictions []string
+	Allergies    []string
+	Targets      *config.NutritionTargets
+	Eaten        config.NutritionalInfo
+}
+
+// Ranked is a recommendation with its final score and the reasons behind it.
+type Ranked struct {
+	config.Recipe
+	Score   float64  `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+// Personalize drops candidates that break the user's restrictions or
+// allergies and reranks the rest by ingredient similarity and by how well
+// one serving fits the calories and macros still left for the day. At most
+// k recipes are returned.
+func Personalize(candidates []config.Recipe, prefs Preferences, k int) []Ranked {
+	meal, remaining := mealTarget(prefs)
+
+	// Without metadata CheckDiet falls back to its word lists.
+	var taxonomy map[string]config.IngredientInfo
+	if len(prefs.Restrictions) > 0 || len(prefs.Allergies) > 0 {
+		taxonomy, _ = database.IngredientTaxonomy()
+	}
+
+	var full map[string]config.Recipe
+	if prefs.Targets != nil || len(prefs.Restrictions) > 0 || len(prefs.Allergies) > 0 {
+		full = loadRecipes(candidates)
+	}
+
+	ranked := make([]Ranked, 0, len(candidates))
+	for _, candidate := range candidates {
+		recipe := candidate
+		if full, ok := full[recipeKey(candidate)]; ok {
+			recipe.ID = full.ID
+			recipe.Ingredients = full.Ingredients
+			recipe.Servings = full.Servings
+			recipe.Nutrition = full.Nutrition
+			recipe.Vegan, recipe.Vegetarian, recipe.Allergens = full.Vegan, full.Vegetarian, full.Allergens
+		}
+
+		names := make([]string, 0, len(recipe.Ingredients))
+		for _, ing := range recipe.Ingredients {
+			names = append(names, ing.Name)
+		}
+		if len(nutrition.CheckDiet(names, prefs.Restrictions, prefs.Allergies, taxonomy)) > 0 {
+			continue
+		}
+
+		r := Ranked{Recipe: recipe, Score: recipe.Similarity}
+		r.Reasons = append(r.Reasons, similarityReason(recipe))
+		if len(prefs.Restrictions) > 0 {
+			r.Reasons = append(r.Reasons, "Fits your diet: "+strings.Join(prefs.Restrictions, ", "))
+		}
+		if len(prefs.Allergies) > 0 {
+			r.Reasons = append(r.Reasons, "Free of your allergens: "+strings.Join(prefs.Allergies, ", "))
+		}
+
+		if prefs.Targets != nil && recipe.Nutrition != nil {
+			fit, reasons := fitScore(recipe.Nutrition.PerServing, meal, remaining)
+			r.Score = similarityWeight*recipe.Similarity + fitWeight*fit
+			r.Reasons = append(r.Reasons, reasons...)
+		}
+		r.Ingredients = stripNutrition(r.Ingredients)
+		ranked = append(ranked, r)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Name < ranked[j].Name
+	})
+	if k > 0 && len(ranked) > k {
+		ranked = ranked[:k]
+	}
+	return ranked
+}
+
+// mealTarget splits what is left of the day's targets into the share one
+// meal should cover: a third of the daily calories, or less if less is left.
+func mealTarget(prefs Preferences) (meal, remaining config.NutritionalInfo) {
+	t := prefs.Targets
+	if t == nil {
+		return
+	}
+
+	remaining = config.NutritionalInfo{
+		Calories: math.Max(0, t.Calories-prefs.Eaten.Calories),
+		Proteins: math.Max(0, t.Proteins-prefs.Eaten.Proteins),
+		Carbs:    math.Max(0, t.Carbs-prefs.Eaten.Carbs),
+		Fats:     math.Max(0, t.Fats-prefs.Eaten.Fats),
+	}
+	if remaining.Calories == 0 {
+		return
+	}
+
+	mealCalories := math.Min(remaining.Calories, t.Calories/mealsPerDay)
+	meal = remaining.Scaled(mealCalories / remaining.Calories)
+	return
+}
+
+func fitScore(serving, meal, remaining config.NutritionalInfo) (float64, []string) {
+	if meal.Calories == 0 {
+		return closeness(serving.Calories, 0), []string{"You have already reached today's calorie target"}
+	}
+
+	calorieFit := closeness(serving.Calories, meal.Calories)
+	macroFit := (closeness(serving.Proteins, meal.Proteins) +
+		closeness(serving.Carbs, meal.Carbs) +
+		closeness(serving.Fats, meal.Fats)) / 3
+
+	var reasons []string
+	switch {
+	case serving.Calories <= meal.Calories*1.15:
+		reasons = append(reasons, fmt.Sprintf("A serving (%.0f kcal) fits your next meal (about %.0f kcal, %.0f kcal left today)",
+			serving.Calories, meal.Calories, remaining.Calories))
+	default:
+		reasons = append(reasons, fmt.Sprintf("A serving (%.0f kcal) is more than your next meal should be (about %.0f kcal, %.0f kcal left today)",
+			serving.Calories, meal.Calories, remaining.Calories))
+	}
+	if meal.Proteins > 0 && serving.Proteins >= meal.Proteins*0.8 {
+		reasons = append(reasons, fmt.Sprintf("Provides %.0f g of the %.0f g protein you still need today",
+			serving.Proteins, remaining.Proteins))
+	}
+	return (calorieFit + macroFit) / 2, reasons
+}
+
+// closeness is 1 when x hits target and falls linearly to 0 at twice the
+// target or at zero.
+func closeness(x, target float64) float64 {
+	if target <= 0 {
+		if x <= 0 {
+			return 1
+		}
+		return 0
+	}
+	return math.Max(0, 1-math.Abs(x-target)/target)
+}
+
+func similarityReason(recipe config.Recipe) string {
+	matched := len(recipe.Ingredients) - len(recipe.MissingIngredients)
+	if recipe.MissingIngredients != nil && len(recipe.Ingredients) > 0 {
+		return fmt.Sprintf("You have %d of its %d ingredients (%.0f%% match)",
+			matched, len(recipe.Ingredients), recipe.Similarity*100)
+	}
+	return fmt.Sprintf("%.0f%% match with your ingredients", recipe.Similarity*100)
+}
+
+// loadRecipes loads every candidate in one go, keyed by recipeKey. A
+// provider may name a recipe without giving its ID.
+func loadRecipes(candidates []config.Recipe) map[string]config.Recipe {
+	var ids []int
+	var names []string
+	for _, c := range candidates {
+		if c.ID > 0 {
+			ids = append(ids, c.ID)
+		} else {
+			names = append(names, c.Name)
+		}
+	}
+	recipes, err := database.LoadRecipes(ids, names)
+	if err != nil {
+		log.Printf("Error loading recommended recipes: %v", err)
+		return nil
+	}
+
+	byKey := make(map[string]config.Recipe, 2*len(recipes))
+	for _, r := range recipes {
+		byKey[recipeKey(r)] = r
+		byKey[strings.ToLower(r.Name)] = r
+	}
+	return byKey
+}
+
+func recipeKey(recipe config.Recipe) string {
+	if recipe.ID > 0 {
+		return "#" + strconv.Itoa(recipe.ID)
+	}
+	return strings.ToLower(recipe.Name)
+}
+
+func stripNutrition(ingredients []config.Ingredient) []config.Ingredient {
+	stripped := make([]config.Ingredient, 0, len(ingredients))
+	for _, ing := range ingredients {
+		stripped = append(stripped, config.Ingredient{TemplateIngredient: ing.TemplateIngredient})
+	}
+	return stripped
+}
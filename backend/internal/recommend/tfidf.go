@@ -0,0 +1,231 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+// Package recommend ranks recipes against a list of available ingredients
+// with TF-IDF vectors and cosine similarity, matching the defaults of the
+// scikit-learn TfidfVectorizer used by recommend.py.
+package recommend
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"errors"
+	"log"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// tokenRe mirrors scikit-learn's default token pattern (?u)\b\w\w+\b.
+var tokenRe = regexp.MustCompile(`[\p{L}\p{N}_]{2,}`)
+
+func tokenize(text string) []string {
+	return tokenRe.FindAllString(strings.ToLower(text), -1)
+}
+
+type document struct {
+	recipe config.Recipe
+	tf     map[string]float64
+}
+
+// Index holds one document per recipe, built from its ingredient names.
+// Document frequencies are kept up to date on every change; vector norms
+// depend on them and are recomputed lazily on the next query.
+type Index struct {
+	mu    sync.Mutex
+	docs  map[int]*document
+	df    map[string]int
+	norms map[int]float64
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs: make(map[int]*document),
+		df:   make(map[string]int),
+	}
+}
+
+// BuildIndex indexes every recipe in the database.
+func BuildIndex() (*Index, error) {
+	recipes, err := database.ListRecipes()
+	if err != nil {
+		return nil, err
+	}
+
+	ix := NewIndex()
+	for _, recipe := range recipes {
+		ix.Upsert(recipe)
+	}
+	return ix, nil
+}
+
+// Sync reloads one recipe from the database, removing it from the index if
+// it no longer exists. It is meant to be registered with
+// database.OnRecipeChange.
+func (ix *Index) Sync(id int) {
+	recipe, err := database.GetRecipeByID(id)
+	if errors.Is(err, database.ErrRecipeNotFound) {
+		ix.Remove(id)
+		return
+	}
+	if err != nil {
+		log.Printf("Error refreshing recipe %d in recommender index: %v", id, err)
+		return
+	}
+	ix.Upsert(recipe)
+}
+
+func (ix *Index) Upsert(recipe config.Recipe) {
+	names := make([]string, 0, len(recipe.Ingredients))
+	ingredients := make([]config.Ingredient, 0, len(recipe.Ingredients))
+	for _, ing := range recipe.Ingredients {
+		names = append(names, ing.Name)
+		ingredients = append(ingredients, config.Ingredient{TemplateIngredient: config.TemplateIngredient{Name: ing.Name}})
+	}
+
+	doc := &document{
+		recipe: config.Recipe{
+			ID:          recipe.ID,
+			Name:        recipe.Name,
+			Description: recipe.Description,
+			Ingredients: ingredients,
+		},
+		tf: make(map[string]float64),
+	}
+	for _, term := range tokenize(strings.Join(names, " ")) {
+		doc.tf[term]++
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.removeLocked(recipe.ID)
+	ix.docs[recipe.ID] = doc
+	for term := range doc.tf {
+		ix.df[term]++
+	}
+	ix.norms = nil
+}
+
+func (ix *Index) Remove(id int) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.removeLocked(id)
+}
+
+func (ix *Index) removeLocked(id int) {
+	old, ok := ix.docs[id]
+	if !ok {
+		return
+	}
+	for term := range old.tf {
+		if ix.df[term]--; ix.df[term] <= 0 {
+			delete(ix.df, term)
+		}
+	}
+	delete(ix.docs, id)
+	ix.norms = nil
+}
+
+func (ix *Index) Len() int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	return len(ix.docs)
+}
+
+// idf uses smoothed inverse document frequency: ln((1+n)/(1+df)) + 1.
+func (ix *Index) idf(term string) float64 {
+	return math.Log(float64(1+len(ix.docs))/float64(1+ix.df[term])) + 1
+}
+
+func (ix *Index) computeNorms() {
+	ix.norms = make(map[int]float64, len(ix.docs))
+	for id, doc := range ix.docs {
+		var sum float64
+		for term, tf := range doc.tf {
+			w := tf * ix.idf(term)
+			sum += w * w
+		}
+		ix.norms[id] = math.Sqrt(sum)
+	}
+}
+
+// Recommend returns up to k recipes with a positive cosine similarity to
+// the available ingredients, best first, each listing the ingredients the
+// user does not have.
+func (ix *Index) Recommend(available []string, k int) []config.Recipe {
+	have := make(map[string]bool, len(available))
+	queryTF := make(map[string]float64)
+	for _, name := range available {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		have[name] = true
+		for _, term := range tokenize(name) {
+			queryTF[term]++
+		}
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if ix.norms == nil {
+		ix.computeNorms()
+	}
+
+	query := make(map[string]float64, len(queryTF))
+	var queryNorm float64
+	for term, tf := range queryTF {
+		if ix.df[term] == 0 {
+			continue
+		}
+		w := tf * ix.idf(term)
+		query[term] = w
+		queryNorm += w * w
+	}
+	results := make([]config.Recipe, 0)
+	if queryNorm == 0 {
+		return results
+	}
+	queryNorm = math.Sqrt(queryNorm)
+
+	for id, doc := range ix.docs {
+		var dot float64
+		for term, qw := range query {
+			if tf, ok := doc.tf[term]; ok {
+				dot += qw * tf * ix.idf(term)
+			}
+		}
+		if dot == 0 || ix.norms[id] == 0 {
+			continue
+		}
+
+		recipe := doc.recipe
+		recipe.Similarity = dot / (queryNorm * ix.norms[id])
+		recipe.MissingIngredients = make([]string, 0)
+		for _, ing := range recipe.Ingredients {
+			if !have[strings.ToLower(ing.Name)] {
+				recipe.MissingIngredients = append(recipe.MissingIngredients, ing.Name)
+			}
+		}
+		results = append(results, recipe)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Similarity != results[j].Similarity {
+			return results[i].Similarity > results[j].Similarity
+		}
+		return results[i].Name < results[j].Name
+	})
+	if k > 0 && len(results) > k {
+		results = results[:k]
+	}
+	return results
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package recommend
+
+import (
+	"FoodStats/internal/config"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// goldenCase is one file in testdata/tfidf. Expected lists the recipes
+// with a positive similarity, best first, as scikit-learn scores them the
+// way recommender.py does:
+//
+//	v = TfidfVectorizer()
+//	m = v.fit_transform([" ".join(r["ingredients"]) for r in recipes])
+//	cosine_similarity(v.transform([" ".join(available)]), m)
+type goldenCase struct {
+	Recipes []struct {
+		Name        string   `json:"name"`
+		Ingredients []string `json:"ingredients"`
+	} `json:"recipes"`
+	Available []string `json:"available"`
+	Expected  []struct {
+		Name       string  `json:"name"`
+		Similarity float64 `json:"similarity"`
+	} `json:"expected"`
+}
+
+func TestRecommendMatchesScikitLearn(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "tfidf", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no golden files in testdata/tfidf")
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var golden goldenCase
+			if err := json.Unmarshal(data, &golden); err != nil {
+				t.Fatal(err)
+			}
+
+			ix := NewIndex()
+			for i, r := range golden.Recipes {
+				recipe := config.Recipe{ID: i + 1, Name: r.Name}
+				for _, ing := range r.Ingredients {
+					recipe.Ingredients = append(recipe.Ingredients, config.Ingredient{TemplateIngredient: config.TemplateIngredient{Name: ing}})
+				}
+				ix.Upsert(recipe)
+			}
+
+			got := ix.Recommend(golden.Available, 0)
+			if len(got) != len(golden.Expected) {
+				t.Fatalf("got %d recipes, want %d", len(got), len(golden.Expected))
+			}
+			for i, want := range golden.Expected {
+				if got[i].Name != want.Name {
+					t.Errorf("rank %d = %s, want %s", i+1, got[i].Name, want.Name)
+				}
+				if math.Abs(got[i].Similarity-want.Similarity) > 1e-9 {
+					t.Errorf("%s similarity = %v, want %v", got[i].Name, got[i].Similarity, want.Similarity)
+				}
+			}
+		})
+	}
+}
+
+// TestIndexUpdates checks that document frequencies follow edits, so the
+// index scores like one built from scratch.
+func TestIndexUpdates(t *testing.T) {
+	recipe := func(id int, name string, ingredients ...string) config.Recipe {
+		r := config.Recipe{ID: id, Name: name}
+		for _, ing := range ingredients {
+			r.Ingredients = append(r.Ingredients, config.Ingredient{TemplateIngredient: config.TemplateIngredient{Name: ing}})
+		}
+		return r
+	}
+
+	edited := NewIndex()
+	edited.Upsert(recipe(1, "Porridge", "oats", "water"))
+	edited.Upsert(recipe(2, "Smoothie", "banana", "milk"))
+	edited.Upsert(recipe(3, "Toast", "bread", "butter"))
+	edited.Upsert(recipe(1, "Porridge", "oats", "milk", "honey"))
+	edited.Remove(3)
+
+	fresh := NewIndex()
+	fresh.Upsert(recipe(1, "Porridge", "oats", "milk", "honey"))
+	fresh.Upsert(recipe(2, "Smoothie", "banana", "milk"))
+
+	if edited.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", edited.Len())
+	}
+	got, want := edited.Recommend([]string{"milk", "oats"}, 0), fresh.Recommend([]string{"milk", "oats"}, 0)
+	if len(got) != len(want) {
+		t.Fatalf("got %d recipes, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Name != want[i].Name || math.Abs(got[i].Similarity-want[i].Similarity) > 1e-12 {
+			t.Errorf("rank %d = %s %v, want %s %v", i+1, got[i].Name, got[i].Similarity, want[i].Name, want[i].Similarity)
+		}
+	}
+}
@@ -0,0 +1,422 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+// Package search keeps the ingredient names and their aliases in memory and
+// answers autocomplete queries with ranked prefix, substring and
+// typo-tolerant matches.
+package search
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	DefaultLimit = 10
+	MaxLimit     = 50
+)
+
+// Match scores, best first. Fuzzy matches score below every exact one.
+const (
+	scoreExact      = 100
+	scoreNamePrefix = 80
+	scoreWordPrefix = 60
+	scoreSubstring  = 40
+	scoreFuzzy      = 30
+)
+
+// minTrigramShare is the share of the query's trigrams a name must contain
+// before it is compared by edit distance.
+const minTrigramShare = 0.3
+
+// entry is one searchable name. Aliases get their own entries whose name
+// is the catalogue name they stand for.
+type entry struct {
+	name  string
+	lower string
+	words []string
+}
+
+type trieNode struct {
+	children map[rune]*trieNode
+	ids      []int
+}
+
+// Index is safe for concurrent use. Queries take a read lock, so they
+// never wait on each other, only on a Reload.
+type Index struct {
+	mu       sync.RWMutex
+	entries  []entry
+	root     *trieNode
+	trigrams map[string][]int
+}
+
+func NewIndex() *Index {
+	ix := &Index{}
+	ix.Load(nil, nil)
+	return ix
+}
+
+// BuildIndex indexes every ingredient and alias in the database.
+func BuildIndex() (*Index, error) {
+	ix := NewIndex()
+	if err := ix.Reload(); err != nil {
+		return nil, err
+	}
+	return ix, nil
+}
+
+// Reload replaces the indexed names with the ones in the database.
+func (ix *Index) Reload() error {
+	names, err := database.ListIngredientNames()
+	if err != nil {
+		return err
+	}
+	aliases, err := database.ListAliases()
+	if err != nil {
+		return err
+	}
+	ix.Load(names, aliases)
+	return nil
+}
+
+// Load replaces the indexed names. Aliases of ingredients that are not in
+// names are skipped.
+func (ix *Index) Load(names []string, aliases []config.IngredientAlias) {
+	entries := make([]entry, 0, len(names)+len(aliases))
+	root := &trieNode{}
+	trigrams := make(map[string][]int)
+
+	canonical := make(map[string]string, len(names))
+	for _, name := range names {
+		canonical[strings.ToLower(name)] = name
+	}
+	type pair struct{ key, name string }
+	pairs := make([]pair, 0, len(names)+len(aliases))
+	for _, name := range names {
+		pairs = append(pairs, pair{name, name})
+	}
+	for _, a := range aliases {
+		if name, ok := canonical[strings.ToLower(a.Ingredient)]; ok {
+			pairs = append(pairs, pair{a.Alias, name})
+		}
+	}
+
+	seen := make(map[string]bool, len(pairs))
+	for _, p := range pairs {
+		lower := normalize(p.key)
+		if lower == "" || seen[lower+"\x00"+p.name] {
+			continue
+		}
+		seen[lower+"\x00"+p.name] = true
+
+		id := len(entries)
+		e := entry{name: p.name, lower: lower, words: strings.Fields(lower)}
+		entries = append(entries, e)
+
+		for _, word := range e.words {
+			root.insert(word, id)
+		}
+		for _, tri := range trigramSet(lower) {
+			trigrams[tri] = append(trigrams[tri], id)
+		}
+	}
+
+	ix.mu.Lock()
+	ix.entries, ix.root, ix.trigrams = entries, root, trigrams
+	ix.mu.Unlock()
+}
+
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.entries)
+}
+
+// Search returns at most limit ingredient names matching query, best match
+// first. Ties go to the shorter name, then alphabetically.
+func (ix *Index) Search(query string, limit int) []string {
+	query = normalize(query)
+	if query == "" {
+		return []string{}
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	scores := make(map[int]float64)
+	ix.score(query, scores)
+
+	// An ingredient found under several names keeps its best match.
+	best := make(map[string]int)
+	for id, score := range scores {
+		name := ix.entries[id].name
+		if prev, ok := best[name]; !ok || score > scores[prev] ||
+			(score == scores[prev] && len(ix.entries[id].lower) < len(ix.entries[prev].lower)) {
+			best[name] = id
+		}
+	}
+	ids := make([]int, 0, len(best))
+	for _, id := range best {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := ix.entries[ids[i]], ix.entries[ids[j]]
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		if len(a.lower) != len(b.lower) {
+			return len(a.lower) < len(b.lower)
+		}
+		return a.name < b.name
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	results := make([]string, len(ids))
+	for i, id := range ids {
+		results[i] = ix.entries[id].name
+	}
+	return results
+}
+
+// score adds every entry matching query to scores, keeping the best score
+// seen for each entry.
+func (ix *Index) score(query string, scores map[int]float64) {
+	set := func(id int, s float64) {
+		if s > scores[id] {
+			scores[id] = s
+		}
+	}
+
+	tokens := strings.Fields(query)
+	for _, id := range ix.prefixMatches(tokens) {
+		e := ix.entries[id]
+		switch {
+		case e.lower == query:
+			set(id, scoreExact)
+		case strings.HasPrefix(e.lower, query):
+			set(id, scoreNamePrefix)
+		default:
+			set(id, scoreWordPrefix)
+		}
+	}
+
+	for _, id := range ix.trigramCandidates(query) {
+		e := ix.entries[id]
+		if scores[id] >= scoreWordPrefix {
+			continue
+		}
+		if strings.Contains(e.lower, query) {
+			set(id, scoreSubstring)
+			continue
+		}
+		if d, ok := fuzzyMatch(tokens, e.words); ok {
+			// Each edit costs five points so closer names rank first.
+			set(id, scoreFuzzy-5*float64(d))
+		}
+	}
+
+	// Trigrams need three characters; short queries fall back to a scan.
+	if len([]rune(query)) < 3 {
+		for id, e := range ix.entries {
+			if _, ok := scores[id]; !ok && strings.Contains(e.lower, query) {
+				set(id, scoreSubstring)
+			}
+		}
+	}
+}
+
+// prefixMatches returns the entries in which every query token is the
+// prefix of some word.
+func (ix *Index) prefixMatches(tokens []string) []int {
+	if len(tokens) == 0 {
+		return nil
+	}
+	var result map[int]bool
+	for _, token := range tokens {
+		node := ix.root.find(token)
+		if node == nil {
+			return nil
+		}
+		ids := make(map[int]bool)
+		node.collect(ids)
+		if result != nil {
+			for id := range result {
+				if !ids[id] {
+					delete(result, id)
+				}
+			}
+		} else {
+			result = ids
+		}
+	}
+
+	list := make([]int, 0, len(result))
+	for id := range result {
+		list = append(list, id)
+	}
+	return list
+}
+
+// trigramCandidates returns the entries sharing enough trigrams with query
+// to be worth comparing character by character.
+func (ix *Index) trigramCandidates(query string) []int {
+	tris := trigramSet(query)
+	if len(tris) == 0 {
+		return nil
+	}
+	counts := make(map[int]int)
+	for _, tri := range tris {
+		for _, id := range ix.trigrams[tri] {
+			counts[id]++
+		}
+	}
+
+	need := int(minTrigramShare*float64(len(tris)) + 0.5)
+	if need < 1 {
+		need = 1
+	}
+	var ids []int
+	for id, n := range counts {
+		if n >= need {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// fuzzyMatch reports whether every query token is within the typo budget
+// of a word, or of the start of a word, and returns the total distance.
+func fuzzyMatch(tokens, words []string) (int, bool) {
+	total := 0
+	for _, token := range tokens {
+		budget := typoBudget(token)
+		best := budget + 1
+		for _, word := range words {
+			if d := levenshtein(token, word); d < best {
+				best = d
+			}
+			if r := []rune(word); len([]rune(token)) >= 4 && len(r) > len([]rune(token)) {
+				if d := levenshtein(token, string(r[:len([]rune(token))])); d < best {
+					best = d
+				}
+			}
+		}
+		if best > budget {
+			return 0, false
+		}
+		total += best
+	}
+	return total, true
+}
+
+// typoBudget is the number of edits allowed for a token of this length.
+func typoBudget(token string) int {
+	switch n := len([]rune(token)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func trigramSet(s string) []string {
+	r := []rune(s)
+	if len(r) < 3 {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var tris []string
+	for i := 0; i+3 <= len(r); i++ {
+		tri := string(r[i : i+3])
+		if !seen[tri] {
+			seen[tri] = true
+			tris = append(tris, tri)
+		}
+	}
+	return tris
+}
+
+// normalize lower-cases s and turns punctuation into single spaces, so
+// "Flour, all-purpose" is searched as "flour all purpose".
+func normalize(s string) string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+	})
+	return strings.Join(fields, " ")
+}
+
+func (n *trieNode) insert(word string, id int) {
+	node := n
+	for _, c := range word {
+		if node.children == nil {
+			node.children = make(map[rune]*trieNode)
+		}
+		child, ok := node.children[c]
+		if !ok {
+			child = &trieNode{}
+			node.children[c] = child
+		}
+		node = child
+	}
+	if len(node.ids) == 0 || node.ids[len(node.ids)-1] != id {
+		node.ids = append(node.ids, id)
+	}
+}
+
+func (n *trieNode) find(prefix string) *trieNode {
+	node := n
+	for _, c := range prefix {
+		node = node.children[c]
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func (n *trieNode) collect(ids map[int]bool) {
+	for _, id := range n.ids {
+		ids[id] = true
+	}
+	for _, child := range n.children {
+		child.collect(ids)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package search
+
+import (
+	"FoodStats/internal/config"
+	"reflect"
+	"testing"
+)
+
+func testIndex() *Index {
+	ix := NewIndex()
+	ix.Load([]string{
+		"Apple",
+		"Apple Juice",
+		"Pineapple",
+		"All-Purpose Flour",
+		"Whole Wheat Flour",
+		"Chicken Breast",
+		"Chickpeas",
+		"Tomato",
+		"Sun-Dried Tomatoes",
+		"Zucchini",
+		"Eggplant",
+		"Cilantro",
+		"Jalapeño",
+	}, []config.IngredientAlias{
+		{Alias: "courgette", Locale: "en-GB", Ingredient: "Zucchini"},
+		{Alias: "aubergine", Locale: "en-GB", Ingredient: "Eggplant"},
+		{Alias: "coriander", Locale: "en-GB", Ingredient: "Cilantro"},
+		{Alias: "Kichererbsen", Locale: "de", Ingredient: "Chickpeas"},
+		{Alias: "Apfel", Locale: "de", Ingredient: "apple"},
+		{Alias: "Mehl", Locale: "de", Ingredient: "All-Purpose Flour"},
+		{Alias: "garbanzo beans", Locale: "en-US", Ingredient: "chickpeas"},
+		{Alias: "gone", Locale: "en", Ingredient: "Not In Catalogue"},
+	})
+	return ix
+}
+
+func TestSearch(t *testing.T) {
+	ix := testIndex()
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{"exact beats prefix and substring", "apple", 0, []string{"Apple", "Apple Juice", "Pineapple"}},
+		{"case and punctuation ignored", "ALL-purpose", 0, []string{"All-Purpose Flour"}},
+		{"word prefix", "flo", 0, []string{"All-Purpose Flour", "Whole Wheat Flour"}},
+		{"every token must match", "wheat flour", 0, []string{"Whole Wheat Flour"}},
+		{"name prefix before word prefix", "chick", 0, []string{"Chickpeas", "Chicken Breast"}},
+		{"substring", "ucchin", 0, []string{"Zucchini"}},
+		{"short query scans", "pp", 0, []string{"Apple", "Pineapple", "Apple Juice"}},
+		{"one typo", "tomatos", 0, []string{"Tomato", "Sun-Dried Tomatoes"}},
+		{"typo in a short word", "zuchini", 0, []string{"Zucchini"}},
+		{"two typos in a long word", "chikpeaz", 0, []string{"Chickpeas"}},
+		{"typo in a word prefix", "chiken", 0, []string{"Chicken Breast"}},
+		{"short words need exact letters", "tmo", 0, []string{}},
+		{"typo ties go to the shorter name", "aple", 0, []string{"Apple", "Apple Juice"}},
+		{"limit", "flour", 1, []string{"All-Purpose Flour"}},
+		{"no match", "xyzzy", 0, []string{}},
+		{"empty query", " - ", 0, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ix.Search(tt.query, tt.limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q, %d) = %q, want %q", tt.query, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchAliases(t *testing.T) {
+	ix := testIndex()
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"courgette", []string{"Zucchini"}},
+		{"aubergin", []string{"Eggplant"}},
+		{"coriander", []string{"Cilantro"}},
+		{"kichererbsen", []string{"Chickpeas"}},
+		{"mehl", []string{"All-Purpose Flour"}},
+		// An alias names its ingredient in the catalogue's spelling.
+		{"apfel", []string{"Apple"}},
+		{"garbanzo", []string{"Chickpeas"}},
+		// Found under both its name and an alias, an ingredient is
+		// listed once, ahead of a fuzzy match.
+		{"chickpea", []string{"Chickpeas", "Chicken Breast"}},
+		{"jalapeño", []string{"Jalapeño"}},
+		// Aliases of ingredients missing from the catalogue are dropped.
+		{"gone", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := ix.Search(tt.query, 0); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadReplacesNames(t *testing.T) {
+	ix := testIndex()
+	ix.Load([]string{"Tofu"}, nil)
+	if got := ix.Search("apple", 0); len(got) != 0 {
+		t.Errorf("Search after Load = %q, want nothing", got)
+	}
+	if got := ix.Search("tofu", 0); !reflect.DeepEqual(got, []string{"Tofu"}) {
+		t.Errorf("Search(tofu) = %q", got)
+	}
+	if ix.Len() != 1 {
+		t.Errorf("Len = %d, want 1", ix.Len())
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package shopping
+
+import (
+	"FoodStats/internal/config"
+	"bufio"
+	"io"
+)
+
+func itemName(item config.ShoppingItem) string {
+	if item.DisplayName != "" {
+		return item.DisplayName
+	}
+	return item.Name
+}
+
+// WriteText writes the list as plain text, one heading per food group.
+func WriteText(w io.Writer, list config.ShoppingList) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("Shopping list\n")
+	for _, category := range list.Categories {
+		bw.WriteString("\n" + category.Name + "\n")
+		for _, item := range category.Items {
+			bw.WriteString("  " + itemName(item) + ": " + FormatAmount(item.Quantity, item.Unit) + "\n")
+		}
+	}
+	if len(list.InPantry) > 0 {
+		bw.WriteString("\nAlready in the pantry\n")
+		for _, item := range list.InPantry {
+			bw.WriteString("  " + itemName(item) + "\n")
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteMarkdown writes the list as a Markdown checklist.
+func WriteMarkdown(w io.Writer, list config.ShoppingList) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("# Shopping list\n")
+	for _, category := range list.Categories {
+		bw.WriteString("\n## " + category.Name + "\n\n")
+		for _, item := range category.Items {
+			bw.WriteString("- [ ] " + itemName(item) + " (" + FormatAmount(item.Quantity, item.Unit) + ")\n")
+		}
+	}
+	if len(list.InPantry) > 0 {
+		bw.WriteString("\n## Already in the pantry\n\n")
+		for _, item := range list.InPantry {
+			bw.WriteString("- [x] " + itemName(item) + "\n")
+		}
+	}
+	return bw.Flush()
+}
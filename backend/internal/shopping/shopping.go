@@ -0,0 +1,210 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+// Package shopping turns a set of recipes into a shopping list: the same
+// ingredient is merged across recipes, what the pantry holds is taken off,
+// and the rest is rounded up to amounts a shop sells.
+package shopping
+
+import (
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrNoRecipes = errors.New("no recipes given")
+
+// Pantry reports the grams of each ingredient a session has on hand, keyed
+// by lower-case ingredient name.
+type Pantry interface {
+	OnHand(sessionID string) (map[string]float64, error)
+}
+
+// Item asks for a number of servings of a recipe.
+type Item struct {
+	RecipeID int     `json:"recipe_id"`
+	Servings float64 `json:"servings"`
+}
+
+// otherGroup holds ingredients without a food group.
+const otherGroup = "other"
+
+// coveredGrams is how little may be left to buy for the pantry to count as
+// covering an ingredient.
+const coveredGrams = 0.5
+
+type need struct {
+	name    string
+	grams   float64
+	recipes []string
+}
+
+// Build makes the shopping list for the items, taking off what is in
+// onHand, which may be nil. Servings default to one.
+func Build(items []Item, onHand map[string]float64) (config.ShoppingList, error) {
+	list := config.ShoppingList{Categories: []config.ShoppingCategory{}}
+	if len(items) == 0 {
+		return list, ErrNoRecipes
+	}
+
+	info, err := database.IngredientPurchaseInfo()
+	if err != nil {
+		return list, err
+	}
+
+	needs := make(map[string]*need)
+	var order []string
+	recipes := make(map[int]config.Recipe)
+	for _, item := range items {
+		recipe, ok := recipes[item.RecipeID]
+		if !ok {
+			if recipe, err = database.GetRecipeByID(item.RecipeID); err != nil {
+				return list, fmt.Errorf("recipe %d: %w", item.RecipeID, err)
+			}
+			recipes[item.RecipeID] = recipe
+		}
+		servings := item.Servings
+		if servings == 0 {
+			servings = 1
+		}
+		factor := servings / float64(max(recipe.Servings, 1))
+
+		for _, ing := range recipe.Ingredients {
+			key := catalogueKey(ing.Name, info)
+			n, ok := needs[key]
+			if !ok {
+				name := ing.Name
+				if p, known := info[key]; known {
+					name = p.Name
+				}
+				n = &need{name: name}
+				needs[key] = n
+				order = append(order, key)
+			}
+			n.grams += ing.Grams * factor
+			n.recipes = appendUnique(n.recipes, recipe.Name)
+		}
+	}
+
+	groups := make(map[string]*config.ShoppingCategory)
+	for _, key := range order {
+		n := needs[key]
+		p := info[key]
+		item := config.ShoppingItem{
+			Name:        n.name,
+			NeededGrams: round1(n.grams),
+			Recipes:     n.recipes,
+		}
+		used, left, covered := takeFromPantry(n.grams, onHand[key])
+		if used > 0 {
+			item.PantryGrams = round1(used)
+		}
+		if covered {
+			list.InPantry = append(list.InPantry, item)
+			continue
+		}
+		item.Grams = round1(left)
+		item.Quantity, item.Unit = purchaseAmount(left, p)
+
+		group := p.FoodGroup
+		if group == "" {
+			group = otherGroup
+		}
+		category, ok := groups[group]
+		if !ok {
+			category = &config.ShoppingCategory{FoodGroup: group, Name: groupName(group)}
+			groups[group] = category
+		}
+		category.Items = append(category.Items, item)
+	}
+
+	for _, category := range groups {
+		sort.Slice(category.Items, func(i, j int) bool {
+			return strings.ToLower(category.Items[i].Name) < strings.ToLower(category.Items[j].Name)
+		})
+		list.Categories = append(list.Categories, *category)
+	}
+	sort.Slice(list.Categories, func(i, j int) bool {
+		a, b := list.Categories[i].FoodGroup, list.Categories[j].FoodGroup
+		if (a == otherGroup) != (b == otherGroup) {
+			return b == otherGroup
+		}
+		return a < b
+	})
+	sort.Slice(list.InPantry, func(i, j int) bool {
+		return strings.ToLower(list.InPantry[i].Name) < strings.ToLower(list.InPantry[j].Name)
+	})
+	return list, nil
+}
+
+// takeFromPantry splits the grams needed into what the pantry covers and
+// what is left to buy. Less than coveredGrams left counts as covered.
+func takeFromPantry(needed, have float64) (used, left float64, covered bool) {
+	used = min(max(have, 0), needed)
+	left = needed - used
+	return used, left, left < coveredGrams
+}
+
+// PlanItems asks for every planned meal of a meal plan.
+func PlanItems(plan config.MealPlan) []Item {
+	var items []Item
+	for _, day := range plan.Days {
+		for _, meal := range day.Meals {
+			if meal.RecipeID != 0 {
+				items = append(items, Item{RecipeID: meal.RecipeID, Servings: meal.Portion})
+			}
+		}
+	}
+	return items
+}
+
+// Localize sets the display name of every item found in names, which is
+// keyed by lower-case ingredient name.
+func Localize(list *config.ShoppingList, names map[string]string) {
+	localize := func(items []config.ShoppingItem) {
+		for i := range items {
+			if name, ok := names[strings.ToLower(items[i].Name)]; ok {
+				items[i].DisplayName = name
+			}
+		}
+	}
+	for c := range list.Categories {
+		localize(list.Categories[c].Items)
+	}
+	localize(list.InPantry)
+}
+
+// catalogueKey finds the catalogue entry for a recipe ingredient, which
+// may be named in the plural or by an alias.
+func catalogueKey(name string, info map[string]database.PurchaseInfo) string {
+	key := strings.ToLower(name)
+	if _, ok := info[key]; ok {
+		return key
+	}
+	if resolved, err := database.ResolveQuantity(config.TemplateIngredient{Name: name}); err == nil {
+		if _, ok := info[strings.ToLower(resolved.Name)]; ok {
+			return strings.ToLower(resolved.Name)
+		}
+	}
+	return key
+}
+
+// groupName turns a food group key such as "herbs_spices" into a heading.
+func groupName(group string) string {
+	name := strings.ReplaceAll(group, "_", " & ")
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package shopping
+
+import (
+	"FoodStats/internal/database"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// countUnits are the counted units an ingredient is bought in, most
+// natural first. Slices are left out: bread is bought by the loaf.
+var countUnits = []string{"piece", "can", "bunch", "clove"}
+
+// liquidGroups and liquidWords pick the ingredients sold by volume, when
+// their density is known.
+var (
+	liquidGroups = map[string]bool{"beverages": true, "broths_stocks": true}
+	liquidWords  = map[string]bool{"milk": true, "buttermilk": true, "kefir": true, "oil": true,
+		"vinegar": true, "juice": true, "cream": true, "sauce": true}
+)
+
+// countSlack lets a count round down when it is over a whole number by
+// less than this share of an item.
+const countSlack = 0.1
+
+// purchaseAmount rounds grams up to an amount a shop sells: whole pieces
+// or cans when the ingredient has a standard weight for them, millilitres
+// or litres for liquids, and grams or kilograms otherwise.
+func purchaseAmount(grams float64, p database.PurchaseInfo) (float64, string) {
+	for _, unit := range countUnits {
+		if portion := p.Portions[unit]; portion > 0 {
+			return math.Max(1, math.Ceil(grams/portion-countSlack)), unit
+		}
+	}
+	if p.Density > 0 && isLiquid(p) {
+		amount, large := roundUp(grams / p.Density)
+		if large {
+			return amount, "l"
+		}
+		return amount, "ml"
+	}
+	amount, large := roundUp(grams)
+	if large {
+		return amount, "kg"
+	}
+	return amount, "g"
+}
+
+func isLiquid(p database.PurchaseInfo) bool {
+	if liquidGroups[p.FoodGroup] {
+		return true
+	}
+	words := strings.Fields(strings.ToLower(p.Name))
+	return len(words) > 0 && liquidWords[words[len(words)-1]]
+}
+
+// roundUp rounds an amount in grams or millilitres up to the next 5 below
+// 100, the next 50 below 1000, and otherwise the next tenth of a kilogram
+// or litre, which is returned with large set.
+func roundUp(amount float64) (float64, bool) {
+	switch {
+	case amount < 100:
+		return math.Max(5, math.Ceil(amount/5)*5), false
+	case amount <= 1000:
+		return math.Ceil(amount/50) * 50, false
+	default:
+		return math.Ceil(amount/100) / 10, true
+	}
+}
+
+// FormatAmount writes a quantity with its unit, such as "3 pieces" or
+// "1.5 kg".
+func FormatAmount(quantity float64, unit string) string {
+	amount := strconv.FormatFloat(quantity, 'f', -1, 64)
+	switch unit {
+	case "g", "kg", "ml", "l":
+		return amount + " " + unit
+	}
+	if quantity != 1 {
+		if strings.HasSuffix(unit, "ch") {
+			unit += "es"
+		} else {
+			unit += "s"
+		}
+	}
+	return amount + " " + unit
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package shopping
+
+import (
+	"FoodStats/internal/database"
+	"testing"
+)
+
+func TestRoundUp(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   float64
+		large  bool
+	}{
+		{0.3, 5, false},
+		{5, 5, false},
+		{12, 15, false},
+		{99.9, 100, false},
+		{100, 100, false},
+		{101, 150, false},
+		{640, 650, false},
+		{1000, 1000, false},
+		{1001, 1.1, true},
+		{2340, 2.4, true},
+	}
+	for _, tt := range tests {
+		if got, large := roundUp(tt.amount); got != tt.want || large != tt.large {
+			t.Errorf("roundUp(%v) = %v, %v; want %v, %v", tt.amount, got, large, tt.want, tt.large)
+		}
+	}
+}
+
+func TestPurchaseAmount(t *testing.T) {
+	egg := database.PurchaseInfo{Name: "Egg", FoodGroup: "eggs", Portions: map[string]float64{"piece": 50}}
+	garlic := database.PurchaseInfo{Name: "Garlic", FoodGroup: "vegetables", Portions: map[string]float64{"clove": 5, "piece": 40}}
+	tomatoes := database.PurchaseInfo{Name: "Canned Tomatoes", FoodGroup: "vegetables", Portions: map[string]float64{"can": 400}}
+	milk := database.PurchaseInfo{Name: "Whole Milk", FoodGroup: "dairy", Density: 1.03}
+	stock := database.PurchaseInfo{Name: "Vegetable Stock", FoodGroup: "broths_stocks", Density: 1}
+	honey := database.PurchaseInfo{Name: "Honey", FoodGroup: "sweets", Density: 1.42}
+	flour := database.PurchaseInfo{Name: "All-Purpose Flour", FoodGroup: "grains", Density: 0.53}
+
+	tests := []struct {
+		name  string
+		grams float64
+		info  database.PurchaseInfo
+		qty   float64
+		unit  string
+	}{
+		{"whole pieces", 120, egg, 3, "piece"},
+		{"at least one piece", 10, egg, 1, "piece"},
+		{"slack rounds down", 152, egg, 3, "piece"},
+		{"just over the slack", 156, egg, 4, "piece"},
+		{"first count unit wins", 30, garlic, 1, "piece"},
+		{"cans", 600, tomatoes, 2, "can"},
+		{"liquid by name", 300, milk, 300, "ml"},
+		{"liquid by food group", 1500, stock, 1.5, "l"},
+		{"dense food is not a liquid", 200, honey, 200, "g"},
+		{"no count unit", 730, flour, 750, "g"},
+		{"kilograms", 1234, flour, 1.3, "kg"},
+		{"unknown ingredient", 42, database.PurchaseInfo{}, 45, "g"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qty, unit := purchaseAmount(tt.grams, tt.info)
+			if qty != tt.qty || unit != tt.unit {
+				t.Errorf("purchaseAmount(%v, %s) = %v %s, want %v %s", tt.grams, tt.info.Name, qty, unit, tt.qty, tt.unit)
+			}
+		})
+	}
+}
+
+func TestTakeFromPantry(t *testing.T) {
+	tests := []struct {
+		name    string
+		needed  float64
+		have    float64
+		used    float64
+		left    float64
+		covered bool
+	}{
+		{"empty pantry", 200, 0, 0, 200, false},
+		{"partly covered", 200, 150, 150, 50, false},
+		{"exactly covered", 200, 200, 200, 0, true},
+		{"more than needed", 200, 500, 200, 0, true},
+		{"crumb left over", 200, 199.6, 199.6, 0.4, true},
+		{"half a gram left", 200, 199.5, 199.5, 0.5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used, left, covered := takeFromPantry(tt.needed, tt.have)
+			if used != tt.used || !closeTo(left, tt.left) || covered != tt.covered {
+				t.Errorf("takeFromPantry(%v, %v) = %v, %v, %v; want %v, %v, %v",
+					tt.needed, tt.have, used, left, covered, tt.used, tt.left, tt.covered)
+			}
+		})
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		qty  float64
+		unit string
+		want string
+	}{
+		{1, "piece", "1 piece"},
+		{3, "piece", "3 pieces"},
+		{2, "bunch", "2 bunches"},
+		{1.5, "kg", "1.5 kg"},
+		{250, "ml", "250 ml"},
+	}
+	for _, tt := range tests {
+		if got := FormatAmount(tt.qty, tt.unit); got != tt.want {
+			t.Errorf("FormatAmount(%v, %q) = %q, want %q", tt.qty, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func closeTo(a, b float64) bool {
+	return a-b < 1e-9 && b-a < 1e-9
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package units
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Quantity struct {
+	Amount float64
+	Unit   Unit
+	Name   string
+	// Unmeasured is set when the line gave neither an amount nor a unit,
+	// as in "salt" or "pepper to taste". Amount is then one piece, which
+	// callers may replace with a pinch when the ingredient has no standard
+	// piece weight.
+	Unmeasured bool
+}
+
+var ErrInvalidQuantity = errors.New("invalid quantity")
+
+var unicodeFractions = map[rune]float64{
+	'½': 0.5, '⅓': 1.0 / 3, '⅔': 2.0 / 3, '¼': 0.25, '¾': 0.75,
+	'⅕': 0.2, '⅖': 0.4, '⅗': 0.6, '⅘': 0.8, '⅙': 1.0 / 6, '⅚': 5.0 / 6,
+	'⅛': 0.125, '⅜': 0.375, '⅝': 0.625, '⅞': 0.875,
+}
+
+var (
+	leadingNumberRe = regexp.MustCompile(`^(\d+(?:[.,]\d+)?)(?:\s+(\d+)/(\d+)|/(\d+))?`)
+	rangeRe         = regexp.MustCompile(`^(?:-|–|to\s)\s*`)
+	spaceRe         = regexp.MustCompile(`\s+`)
+)
+
+// articles stand for an amount of one, as in "a pinch of salt".
+var articles = []string{"a ", "an "}
+
+// sizeWords describe a counted item and are not part of its name. The
+// standard piece weight is used whatever the size.
+var sizeWords = []string{"extra-large", "extra large", "large", "medium", "small", "big", "jumbo"}
+
+// noteWords start a preparation note after a comma, as in "flour, sifted".
+// Other text after a comma is kept, since catalogue names such as "Milk,
+// whole" contain commas.
+var noteWords = map[string]bool{
+	"sifted": true, "chopped": true, "diced": true, "minced": true, "sliced": true,
+	"grated": true, "shredded": true, "peeled": true, "melted": true, "softened": true,
+	"beaten": true, "divided": true, "drained": true, "rinsed": true, "crushed": true,
+	"cubed": true, "halved": true, "quartered": true, "trimmed": true, "zested": true,
+	"juiced": true, "cooked": true, "toasted": true, "packed": true, "cut": true,
+	"torn": true, "optional": true, "finely": true, "roughly": true, "coarsely": true,
+	"thinly": true, "freshly": true, "lightly": true, "at": true, "to": true, "for": true,
+	"plus": true, "or": true,
+}
+
+// ParseLine reads a free-text ingredient line such as "2 tbsp olive oil",
+// "1 1/2 cups of milk", "½ cup sugar", "200g chicken breast", "3 eggs",
+// "2 large eggs", "a pinch of salt" or "2-3 cloves garlic". A range counts
+// as its midpoint, and a preparation note after a comma ("flour, sifted")
+// or "to taste" is dropped. A line without a recognised unit is taken as a
+// count of pieces.
+func ParseLine(line string) (Quantity, error) {
+	rest := stripNote(strings.TrimSpace(spaceRe.ReplaceAllString(line, " ")))
+	if rest == "" {
+		return Quantity{}, ErrInvalidQuantity
+	}
+
+	amount, rest, ok, err := parseRange(rest)
+	if err != nil {
+		return Quantity{}, err
+	}
+	if !ok {
+		amount = 1
+		if article, found := cutPrefixFold(rest, articles); found {
+			rest = article
+			ok = true
+		}
+	}
+
+	unit := Piece
+	unitFound := false
+	lower := strings.ToLower(rest)
+	for _, candidate := range unitPrefixes(lower) {
+		if u, err := Lookup(candidate); err == nil && candidate != "" {
+			unit = u
+			unitFound = true
+			rest = strings.TrimSpace(rest[len(candidate):])
+			rest = strings.TrimPrefix(rest, ".")
+			break
+		}
+	}
+
+	rest = strings.TrimSpace(rest)
+	if strings.HasPrefix(strings.ToLower(rest), "of ") {
+		rest = strings.TrimSpace(rest[3:])
+	}
+	if !unitFound {
+		if name, found := cutPrefixFold(rest, sizeWords); found {
+			rest = name
+		}
+	}
+	if rest == "" || amount <= 0 {
+		return Quantity{}, ErrInvalidQuantity
+	}
+
+	return Quantity{Amount: amount, Unit: unit, Name: rest, Unmeasured: !ok && !unitFound}, nil
+}
+
+// stripNote drops a trailing preparation note or "to taste".
+func stripNote(line string) string {
+	for i := strings.Index(line, ","); i >= 0; {
+		after := strings.Fields(strings.ToLower(line[i+1:]))
+		if len(after) > 0 && noteWords[after[0]] {
+			line = strings.TrimSpace(line[:i])
+			break
+		}
+		next := strings.Index(line[i+1:], ",")
+		if next < 0 {
+			break
+		}
+		i += 1 + next
+	}
+	lower := strings.ToLower(line)
+	for _, suffix := range []string{" to taste", " as needed"} {
+		if strings.HasSuffix(lower, suffix) {
+			return strings.TrimSpace(line[:len(line)-len(suffix)])
+		}
+	}
+	return line
+}
+
+// cutPrefixFold removes the first of words that s starts with, ignoring
+// case, when a space follows it.
+func cutPrefixFold(s string, words []string) (string, bool) {
+	lower := strings.ToLower(s)
+	for _, w := range words {
+		w = strings.TrimSpace(w) + " "
+		if strings.HasPrefix(lower, w) {
+			return strings.TrimSpace(s[len(w):]), true
+		}
+	}
+	return s, false
+}
+
+// parseRange reads an amount, or a range such as "2-3" or "2 to 3", which
+// counts as its midpoint.
+func parseRange(s string) (float64, string, bool, error) {
+	low, rest, ok, err := parseAmount(s)
+	if !ok || err != nil {
+		return low, rest, ok, err
+	}
+	m := rangeRe.FindString(rest)
+	if m == "" {
+		return low, rest, true, nil
+	}
+	high, after, ok, err := parseAmount(rest[len(m):])
+	if err != nil {
+		return 0, s, false, err
+	}
+	if !ok {
+		return low, rest, true, nil
+	}
+	if high < low {
+		return 0, s, false, ErrInvalidQuantity
+	}
+	return (low + high) / 2, after, true, nil
+}
+
+// parseAmount reads a leading number, fraction or mixed number. A fraction
+// over zero is an error.
+func parseAmount(s string) (float64, string, bool, error) {
+	var amount float64
+	found := false
+
+	if m := leadingNumberRe.FindStringSubmatch(s); m != nil {
+		whole, err := strconv.ParseFloat(strings.Replace(m[1], ",", ".", 1), 64)
+		if err != nil {
+			return 0, s, false, ErrInvalidQuantity
+		}
+		switch {
+		case m[2] != "":
+			num, _ := strconv.ParseFloat(m[2], 64)
+			den, _ := strconv.ParseFloat(m[3], 64)
+			if den == 0 {
+				return 0, s, false, ErrInvalidQuantity
+			}
+			amount = whole + num/den
+		case m[4] != "":
+			den, _ := strconv.ParseFloat(m[4], 64)
+			if den == 0 {
+				return 0, s, false, ErrInvalidQuantity
+			}
+			amount = whole / den
+		default:
+			amount = whole
+		}
+		s = s[len(m[0]):]
+		found = true
+	}
+
+	if r := []rune(strings.TrimLeft(s, " ")); len(r) > 0 {
+		if frac, ok := unicodeFractions[r[0]]; ok {
+			amount += frac
+			s = string(r[1:])
+			found = true
+		}
+	}
+
+	return amount, strings.TrimSpace(s), found, nil
+}
+
+// unitPrefixes returns the possible unit tokens at the start of s, longest
+// first, so "fl oz" wins over "fl" and "200g" splits into "g".
+func unitPrefixes(s string) []string {
+	fields := strings.Fields(s)
+	var candidates []string
+	if len(fields) >= 2 {
+		candidates = append(candidates, fields[0]+" "+fields[1])
+	}
+	if len(fields) >= 1 {
+		candidates = append(candidates, strings.TrimSuffix(fields[0], "."))
+	}
+	return candidates
+}
+
+// SingularCandidates returns plausible singular forms of an ingredient name
+// ("eggs" -> "egg", "tomatoes" -> "tomato", "berries" -> "berry").
+func SingularCandidates(name string) []string {
+	lower := strings.ToLower(strings.TrimSpace(name))
+	var out []string
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(lower) > 4:
+		out = append(out, lower[:len(lower)-3]+"y")
+	case strings.HasSuffix(lower, "oes"), strings.HasSuffix(lower, "shes"),
+		strings.HasSuffix(lower, "ches"), strings.HasSuffix(lower, "xes"):
+		out = append(out, lower[:len(lower)-2])
+	}
+	if strings.HasSuffix(lower, "s") && !strings.HasSuffix(lower, "ss") && len(lower) > 3 {
+		out = append(out, lower[:len(lower)-1])
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseLine(t *testing.T) {
+	tests := []struct {
+		line       string
+		amount     float64
+		unit       string
+		name       string
+		unmeasured bool
+	}{
+		{"2 tbsp olive oil", 2, "tbsp", "olive oil", false},
+		{"1 1/2 cups of milk", 1.5, "cup", "milk", false},
+		{"½ cup sugar", 0.5, "cup", "sugar", false},
+		{"200g chicken breast", 200, "g", "chicken breast", false},
+		{"3 eggs", 3, "piece", "eggs", false},
+		{"2 large eggs", 2, "piece", "eggs", false},
+		{"1 extra-large onion", 1, "piece", "onion", false},
+		{"a pinch of salt", 1, "pinch", "salt", false},
+		{"An apple", 1, "piece", "apple", false},
+		{"2-3 cloves garlic", 2.5, "clove", "garlic", false},
+		{"2 - 4 cups water", 3, "cup", "water", false},
+		{"1 to 2 tsp honey", 1.5, "tsp", "honey", false},
+		{"2 cups of flour, sifted", 2, "cup", "flour", false},
+		{"1 onion, finely chopped", 1, "piece", "onion", false},
+		{"1 cup Milk, whole, 3.25% milkfat", 1, "cup", "Milk, whole, 3.25% milkfat", false},
+		{"salt", 1, "piece", "salt", true},
+		{"pepper to taste", 1, "piece", "pepper", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			q, err := ParseLine(tt.line)
+			if err != nil {
+				t.Fatalf("ParseLine(%q): %v", tt.line, err)
+			}
+			if math.Abs(q.Amount-tt.amount) > 1e-9 || q.Unit.Name != tt.unit || q.Name != tt.name || q.Unmeasured != tt.unmeasured {
+				t.Errorf("ParseLine(%q) = %v %s %q unmeasured=%v, want %v %s %q unmeasured=%v",
+					tt.line, q.Amount, q.Unit.Name, q.Name, q.Unmeasured, tt.amount, tt.unit, tt.name, tt.unmeasured)
+			}
+		})
+	}
+}
+
+func TestParseLineRejects(t *testing.T) {
+	for _, line := range []string{"", "   ", "1/0 cup milk", "1 1/0 cup milk", "3-2 eggs", "2 cups", "0 eggs"} {
+		t.Run(line, func(t *testing.T) {
+			if q, err := ParseLine(line); !errors.Is(err, ErrInvalidQuantity) {
+				t.Errorf("ParseLine(%q) = %+v, %v; want %v", line, q, err, ErrInvalidQuantity)
+			}
+		})
+	}
+}
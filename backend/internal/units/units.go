@@ -0,0 +1,135 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package units
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Kind int
+
+const (
+	Mass Kind = iota
+	Volume
+	Count
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Mass:
+		return "mass"
+	case Volume:
+		return "volume"
+	default:
+		return "count"
+	}
+}
+
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Unit factors are grams for Mass, millilitres for Volume and 1 for Count,
+// where the weight of one item comes from the ingredient's portion table.
+type Unit struct {
+	Name   string  `json:"name"`
+	Kind   Kind    `json:"kind"`
+	Factor float64 `json:"factor"`
+}
+
+var ErrUnknownUnit = errors.New("unknown unit")
+
+var (
+	Gram       = Unit{Name: "g", Kind: Mass, Factor: 1}
+	Milliliter = Unit{Name: "ml", Kind: Volume, Factor: 1}
+	Piece      = Unit{Name: "piece", Kind: Count, Factor: 1}
+)
+
+var unitsByAlias = map[string]Unit{}
+
+func register(unit Unit, aliases ...string) {
+	unitsByAlias[unit.Name] = unit
+	for _, alias := range aliases {
+		unitsByAlias[alias] = unit
+	}
+}
+
+func init() {
+	register(Gram, "gram", "grams", "gr", "gramme", "grammes")
+	register(Unit{Name: "kg", Kind: Mass, Factor: 1000}, "kilogram", "kilograms", "kilo", "kilos")
+	register(Unit{Name: "mg", Kind: Mass, Factor: 0.001}, "milligram", "milligrams")
+	register(Unit{Name: "oz", Kind: Mass, Factor: 28.349523125}, "ounce", "ounces")
+	register(Unit{Name: "lb", Kind: Mass, Factor: 453.59237}, "lbs", "pound", "pounds")
+
+	register(Milliliter, "milliliter", "milliliters", "millilitre", "millilitres", "mls")
+	register(Unit{Name: "cl", Kind: Volume, Factor: 10}, "centiliter", "centiliters", "centilitre", "centilitres")
+	register(Unit{Name: "dl", Kind: Volume, Factor: 100}, "deciliter", "deciliters", "decilitre", "decilitres")
+	register(Unit{Name: "l", Kind: Volume, Factor: 1000}, "liter", "liters", "litre", "litres")
+	register(Unit{Name: "tsp", Kind: Volume, Factor: 4.92892159375}, "teaspoon", "teaspoons", "tsps")
+	register(Unit{Name: "tbsp", Kind: Volume, Factor: 14.78676478125}, "tablespoon", "tablespoons", "tbsps", "tbs", "tbl")
+	register(Unit{Name: "cup", Kind: Volume, Factor: 240}, "cups", "c")
+	register(Unit{Name: "fl oz", Kind: Volume, Factor: 29.5735295625}, "floz", "fluid ounce", "fluid ounces")
+	register(Unit{Name: "pint", Kind: Volume, Factor: 473.176473}, "pints", "pt")
+	register(Unit{Name: "quart", Kind: Volume, Factor: 946.352946}, "quarts", "qt")
+	register(Unit{Name: "pinch", Kind: Volume, Factor: 0.31}, "pinches")
+	register(Unit{Name: "dash", Kind: Volume, Factor: 0.62}, "dashes")
+
+	register(Piece, "pieces", "pc", "pcs", "each", "item", "items", "")
+	register(Unit{Name: "clove", Kind: Count, Factor: 1}, "cloves")
+	register(Unit{Name: "slice", Kind: Count, Factor: 1}, "slices")
+	register(Unit{Name: "can", Kind: Count, Factor: 1}, "cans", "tin", "tins")
+	register(Unit{Name: "pack", Kind: Count, Factor: 1}, "packs", "package", "packages", "packet", "packets")
+	register(Unit{Name: "bunch", Kind: Count, Factor: 1}, "bunches")
+	register(Unit{Name: "serving", Kind: Count, Factor: 1}, "servings", "portion", "portions")
+}
+
+func Lookup(name string) (Unit, error) {
+	unit, ok := unitsByAlias[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Unit{}, fmt.Errorf("%w: %s", ErrUnknownUnit, name)
+	}
+	return unit, nil
+}
+
+func List() []Unit {
+	seen := make(map[string]bool)
+	var list []Unit
+	for _, unit := range unitsByAlias {
+		if !seen[unit.Name] {
+			seen[unit.Name] = true
+			list = append(list, unit)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Kind != list[j].Kind {
+			return list[i].Kind < list[j].Kind
+		}
+		return list[i].Factor < list[j].Factor
+	})
+	return list
+}
+
+// ToGrams converts an amount in this unit to grams. density is in g/ml and
+// only used for volumes; portion is the weight in grams of one counted item.
+func (u Unit) ToGrams(amount, density, portion float64) (float64, error) {
+	switch u.Kind {
+	case Mass:
+		return amount * u.Factor, nil
+	case Volume:
+		if density <= 0 {
+			return 0, fmt.Errorf("no density known to convert %s to grams", u.Name)
+		}
+		return amount * u.Factor * density, nil
+	default:
+		if portion <= 0 {
+			return 0, fmt.Errorf("no standard weight known for one %s", u.Name)
+		}
+		return amount * portion, nil
+	}
+}
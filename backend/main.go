@@ -5,8 +5,24 @@
 
 package main
 
-import "FoodStats/internal/api"
+import (
+	"FoodStats/internal/api"
+	"FoodStats/internal/cli"
+	"FoodStats/internal/config"
+	"FoodStats/internal/database"
+	"flag"
+	"os"
+)
 
 func main() {
+	dbPath := flag.String("db", "", "path to the SQLite database (overrides FOODSTATS_DB and the config file)")
+	flag.Parse()
+
+	config.SetDBPath(*dbPath)
+	database.SetSeed(seedDB)
+
+	if args := flag.Args(); len(args) > 0 && cli.IsCommand(args[0]) {
+		os.Exit(cli.Run(args))
+	}
 	api.InitServer()
 }
@@ -0,0 +1,11 @@
+// Copyright (c) 2025 @drclcomputers. All rights reserved.
+//
+// This work is licensed under the terms of the MIT license.
+// For a copy, see <https://opensource.org/licenses/MIT>.
+
+package main
+
+import _ "embed"
+
+//go:embed database/nutrition_data.db
+var seedDB []byte
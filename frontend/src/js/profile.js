@@ -62,7 +62,11 @@ class ProfileManager {
             height: parseFloat(formData.get('height')),
             activityLevel: formData.get('activityLevel'),
             goal: formData.get('goal'),
-            dietary_restrictions: formData.getAll('dietary_restrictions')
+            dietary_restrictions: formData.getAll('dietary_restrictions'),
+            allergies: (formData.get('allergies') || '')
+                .split(',')
+                .map(a => a.trim())
+                .filter(a => a)
         };
         
         try {
@@ -131,6 +135,7 @@ class ProfileManager {
         document.getElementById('height').value = data.height || '';
         document.getElementById('activityLevel').value = data.activityLevel || '';
         document.getElementById('goal').value = data.goal || '';
+        document.getElementById('allergies').value = (data.allergies || []).join(', ');
         
         document.querySelectorAll('input[name="dietary_restrictions"]').forEach(checkbox => {
             checkbox.checked = false;